@@ -41,7 +41,7 @@ func cleanup(buildInfo *core.BuildInfo) {
 		fmt.Println(err)
 		fmt.Println("stacktrace from panic: \n" + string(debug.Stack()))
 
-		// This will send an anonymous report on Scaleway's sentry.
+		// This will send an anonymous report on Scaleway's sentry, unless disabled in the CLI config.
 		if buildInfo.IsRelease() {
 			sentry.RecoverPanicAndSendReport(buildInfo.Tags(), buildInfo.Version.String(), err)
 		}
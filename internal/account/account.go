@@ -1,5 +1,14 @@
 package account
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+var accountURL = "https://account.scaleway.com"
+
 // Token represents a Token
 type Token struct {
 	ID             string `json:"id"`
@@ -23,3 +32,32 @@ type LoginRequest struct {
 	Description    string `json:"description,omitempty"`
 	Expires        bool   `json:"expires"`
 }
+
+// GetAPIKey fetches the token bound to the given secret key
+func GetAPIKey(ctx context.Context, secretKey string) (*Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, accountURL+"/tokens/"+secretKey, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := extractHTTPClient(ctx).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not get token: %s", resp.Status)
+	}
+
+	response := &LoginResponse{}
+	err = json.NewDecoder(resp.Body).Decode(response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Token == nil {
+		return nil, fmt.Errorf("could not get token: empty response")
+	}
+
+	return response.Token, nil
+}
@@ -16,3 +16,10 @@ var contextKey = contextKeyType{}
 func InjectHTTPClient(ctx context.Context, httpClient *http.Client) context.Context {
 	return context.WithValue(ctx, contextKey, httpClient)
 }
+
+func extractHTTPClient(ctx context.Context) *http.Client {
+	if httpClient, ok := ctx.Value(contextKey).(*http.Client); ok {
+		return httpClient
+	}
+	return http.DefaultClient
+}
@@ -36,6 +36,13 @@ func TestConfig_ResolveAliases(t *testing.T) {
 			Command:  []string{"instance", "sl", "zone=fr-par-1"},
 			Expected: []string{"instance", "server", "list", "zone=fr-par-1"},
 		},
+		{
+			Aliases: map[string][]string{
+				"psl": {"instance", "server", "list", "--filter", "state=running"},
+			},
+			Command:  []string{"scw", "psl", "-o", "json"},
+			Expected: []string{"scw", "instance", "server", "list", "--filter", "state=running", "-o", "json"},
+		},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("Resolve_TestCase%d", i), func(t *testing.T) {
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"text/template"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -23,9 +24,67 @@ const (
 	DefaultOutput      = "human"
 	configFileTemplate = `# Scaleway CLI config file
 # This config file can be used only with Scaleway CLI (>2.0.0) (https://github.com/scaleway/scaleway-cli)
-# Output sets the output format for all commands you run
+# Output sets the output format for all commands you run, when no output flag is given
 {{ if .Output }}output: {{ .Output }}{{ else }}# output: human{{ end }}
 
+# Output format of each profile, overriding output when the profile is used
+{{- if .ProfilesOutput }}
+profiles_output:
+    {{- range $profile, $output := .ProfilesOutput }}
+    {{ $profile }}: {{ $output }}
+    {{- end }}
+{{- else }}
+# profiles_output:
+#     my-ci-profile: json
+{{- end }}
+
+# Usage statistics and crash reports are sent unless disabled, each one independently
+{{ if .SendUsage }}send_usage: {{ .SendUsage }}{{ else }}# send_usage: true{{ end }}
+{{ if .SendCrashReports }}send_crash_reports: {{ .SendCrashReports }}{{ else }}# send_crash_reports: true{{ end }}
+
+# Assume yes runs destructive commands without asking for a confirmation, like the -y flag
+{{ if .AssumeYes }}assume_yes: true{{ else }}# assume_yes: false{{ end }}
+
+# Max retries is the number of times a request failing because of rate limiting or of a transient API error is sent again
+{{ if .MaxRetries }}max_retries: {{ .MaxRetries }}{{ else }}# max_retries: 3{{ end }}
+
+# Request timeout is the timeout of each API request and of the waits, like the --timeout flag
+{{ if .RequestTimeout }}request_timeout: {{ .RequestTimeout }}{{ else }}# request_timeout: 30s{{ end }}
+{{- if .ProfilesRequestTimeout }}
+profiles_request_timeout:
+    {{- range $profile, $timeout := .ProfilesRequestTimeout }}
+    {{ $profile }}: {{ $timeout }}
+    {{- end }}
+{{- else }}
+# profiles_request_timeout:
+#     my-ci-profile: 10s
+{{- end }}
+
+# CA file adds the certificates of a PEM file to the trusted ones, e.g. for a proxy inspecting TLS traffic, like the --ca-file flag
+# Insecure skip verify disables the verification of TLS certificates, like the --insecure-skip-verify flag
+{{ if .CAFile }}ca_file: {{ .CAFile }}{{ else }}# ca_file: /etc/ssl/certs/corporate-proxy.pem{{ end }}
+{{ if .InsecureSkipVerify }}insecure_skip_verify: true{{ else }}# insecure_skip_verify: false{{ end }}
+
+# Confirm dangerous set to strict asks to type the resource name before destroying its data
+{{ if .ConfirmDangerous }}confirm_dangerous: {{ .ConfirmDangerous }}{{ else }}# confirm_dangerous: strict{{ end }}
+{{- if .ProfilesConfirmDangerous }}
+profiles_confirm_dangerous:
+    {{- range $profile, $mode := .ProfilesConfirmDangerous }}
+    {{ $profile }}: {{ $mode }}
+    {{- end }}
+{{- else }}
+# profiles_confirm_dangerous:
+#     my-prod-profile: strict
+{{- end }}
+{{- if .ProfilesUpdatedAt }}
+
+# Last time each profile was written by scw init, used by scw config prune
+profiles_updated_at:
+    {{- range $profile, $date := .ProfilesUpdatedAt }}
+    {{ $profile }}: {{ $date.Format "2006-01-02T15:04:05Z07:00" }}
+    {{- end }}
+{{- end }}
+
 # Alias creates custom aliases for your Scaleway CLI commands
 {{- if .Alias }}
 alias:
@@ -47,9 +106,26 @@ alias:
 `
 )
 
+// ConfirmDangerousStrict requires typing the resource name to confirm operations destroying data
+const ConfirmDangerousStrict = "strict"
+
 type Config struct {
-	Alias  *alias.Config `json:"alias"`
-	Output string        `json:"output"`
+	Alias              *alias.Config `json:"alias"`
+	Output             string        `json:"output"`
+	SendUsage          *bool         `json:"send_usage" yaml:"send_usage"`
+	SendCrashReports   *bool         `json:"send_crash_reports" yaml:"send_crash_reports"`
+	AssumeYes          bool          `json:"assume_yes" yaml:"assume_yes"`
+	ConfirmDangerous   string        `json:"confirm_dangerous" yaml:"confirm_dangerous"`
+	MaxRetries         *int          `json:"max_retries" yaml:"max_retries"`
+	RequestTimeout     time.Duration `json:"request_timeout" yaml:"request_timeout"`
+	CAFile             string        `json:"ca_file" yaml:"ca_file"`
+	InsecureSkipVerify bool          `json:"insecure_skip_verify" yaml:"insecure_skip_verify"`
+
+	ProfilesOutput    map[string]string    `json:"profiles_output" yaml:"profiles_output"`
+	ProfilesUpdatedAt map[string]time.Time `json:"profiles_updated_at" yaml:"profiles_updated_at"`
+
+	ProfilesConfirmDangerous map[string]string        `json:"profiles_confirm_dangerous" yaml:"profiles_confirm_dangerous"`
+	ProfilesRequestTimeout   map[string]time.Duration `json:"profiles_request_timeout" yaml:"profiles_request_timeout"`
 
 	path string
 }
@@ -124,6 +200,55 @@ func (c *Config) HumanConfig() (string, error) {
 	return buf.String(), nil
 }
 
+// ProfileOutput returns the default output format of the given profile,
+// falling back to the output of the whole config when the profile has none.
+func (c *Config) ProfileOutput(profileName string) string {
+	if output := c.ProfilesOutput[profileName]; output != "" {
+		return output
+	}
+	return c.Output
+}
+
+// ProfileConfirmDangerous returns the confirmation mode of dangerous operations for the given profile,
+// falling back to the mode of the whole config when the profile has none.
+func (c *Config) ProfileConfirmDangerous(profileName string) string {
+	if mode := c.ProfilesConfirmDangerous[profileName]; mode != "" {
+		return mode
+	}
+	return c.ConfirmDangerous
+}
+
+// ProfileRequestTimeout returns the timeout of API requests for the given profile,
+// falling back to the timeout of the whole config when the profile has none.
+func (c *Config) ProfileRequestTimeout(profileName string) time.Duration {
+	if timeout := c.ProfilesRequestTimeout[profileName]; timeout != 0 {
+		return timeout
+	}
+	return c.RequestTimeout
+}
+
+// TelemetryKind is the kind of data sent by a telemetry event
+type TelemetryKind string
+
+const (
+	TelemetryUsage       = TelemetryKind("usage")
+	TelemetryCrashReport = TelemetryKind("crash_report")
+)
+
+// IsTelemetryEnabled reports whether events of the given kind may be sent.
+// Every telemetry event must go through this check, events are sent unless disabled
+// as crash reports were always sent before they could be disabled.
+func (c *Config) IsTelemetryEnabled(kind TelemetryKind) bool {
+	var enabled *bool
+	switch kind {
+	case TelemetryUsage:
+		enabled = c.SendUsage
+	case TelemetryCrashReport:
+		enabled = c.SendCrashReports
+	}
+	return enabled == nil || *enabled
+}
+
 func FilePath() (string, error) {
 	file := os.Getenv(ScwConfigPathEnv)
 	if file != "" {
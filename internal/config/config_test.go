@@ -0,0 +1,122 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/config"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_IsTelemetryEnabled(t *testing.T) {
+	tests := []struct {
+		Name                string
+		Config              *config.Config
+		ExpectedUsage       bool
+		ExpectedCrashReport bool
+	}{
+		{
+			Name:                "Nothing set",
+			Config:              &config.Config{},
+			ExpectedUsage:       true,
+			ExpectedCrashReport: true,
+		},
+		{
+			Name:                "Usage only",
+			Config:              &config.Config{SendUsage: scw.BoolPtr(true), SendCrashReports: scw.BoolPtr(false)},
+			ExpectedUsage:       true,
+			ExpectedCrashReport: false,
+		},
+		{
+			Name:                "Crash reports only",
+			Config:              &config.Config{SendUsage: scw.BoolPtr(false)},
+			ExpectedUsage:       false,
+			ExpectedCrashReport: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.ExpectedUsage, tt.Config.IsTelemetryEnabled(config.TelemetryUsage))
+			assert.Equal(t, tt.ExpectedCrashReport, tt.Config.IsTelemetryEnabled(config.TelemetryCrashReport))
+		})
+	}
+}
+
+func TestConfig_SaveTelemetry(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "cli.yaml")
+
+	cfg, err := config.LoadConfig(configPath)
+	require.NoError(t, err)
+	cfg.SendUsage = scw.BoolPtr(true)
+	cfg.SendCrashReports = scw.BoolPtr(false)
+	require.NoError(t, cfg.Save())
+
+	content, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "send_usage: true")
+	assert.Contains(t, string(content), "send_crash_reports: false")
+
+	cfg, err = config.LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.True(t, cfg.IsTelemetryEnabled(config.TelemetryUsage))
+	assert.False(t, cfg.IsTelemetryEnabled(config.TelemetryCrashReport))
+}
+
+func TestConfig_ProfileOutput(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "cli.yaml")
+
+	cfg, err := config.LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, config.DefaultOutput, cfg.ProfileOutput("ci"))
+
+	cfg.Output = "yaml"
+	cfg.ProfilesOutput = map[string]string{"ci": "json"}
+	require.NoError(t, cfg.Save())
+
+	cfg, err = config.LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "json", cfg.ProfileOutput("ci"))
+	assert.Equal(t, "yaml", cfg.ProfileOutput("default"))
+}
+
+func TestConfig_ProfileConfirmDangerous(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "cli.yaml")
+
+	cfg, err := config.LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "", cfg.ProfileConfirmDangerous("prod"))
+
+	cfg.ProfilesConfirmDangerous = map[string]string{"prod": config.ConfirmDangerousStrict}
+	require.NoError(t, cfg.Save())
+
+	cfg, err = config.LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, config.ConfirmDangerousStrict, cfg.ProfileConfirmDangerous("prod"))
+	assert.Equal(t, "", cfg.ProfileConfirmDangerous("default"))
+}
+
+func TestConfig_ProfileRequestTimeout(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "cli.yaml")
+
+	cfg, err := config.LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), cfg.ProfileRequestTimeout("ci"))
+
+	cfg.RequestTimeout = 2 * time.Minute
+	cfg.ProfilesRequestTimeout = map[string]time.Duration{"ci": 10 * time.Second}
+	require.NoError(t, cfg.Save())
+
+	content, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "request_timeout: 2m0s")
+
+	cfg, err = config.LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, cfg.ProfileRequestTimeout("ci"))
+	assert.Equal(t, 2*time.Minute, cfg.ProfileRequestTimeout("default"))
+}
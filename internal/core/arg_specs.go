@@ -90,6 +90,13 @@ type ArgSpec struct {
 	// AutoCompleteFunc is used to autocomplete possible values for a given argument.
 	AutoCompleteFunc AutoCompleteArgFunc
 
+	// EnumValuesFunc lists the possible values of the argument from the API when they change over time, e.g. commercial types.
+	// Listed values are cached and used by the autocompletion instead of EnumValues.
+	EnumValuesFunc EnumValuesFunc
+
+	// EnumValuesPrefixFunc normalizes the value being completed before matching it with the values listed by EnumValuesFunc.
+	EnumValuesPrefixFunc func(prefix string) string
+
 	// ValidateFunc validates an argument.
 	ValidateFunc ArgSpecValidateFunc
 
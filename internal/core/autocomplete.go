@@ -39,6 +39,10 @@ const (
 // It is retrieved from core.ArgSpec.AutoCompleteFunc.
 type AutoCompleteArgFunc func(ctx context.Context, prefix string, request any) AutocompleteSuggestions
 
+// EnumValuesFunc is the function called to list the possible values of an argument from the API.
+// It is retrieved from core.ArgSpec.EnumValuesFunc.
+type EnumValuesFunc func(ctx context.Context, request any) ([]string, error)
+
 // AutoCompleteNode is a node in the AutoComplete Tree.
 // An AutoCompleteNode can either represent a command, a subcommand, or a command argument.
 type AutoCompleteNode struct {
@@ -358,7 +362,7 @@ func AutoComplete(ctx context.Context, leftWords []string, wordToComplete string
 
 // AutoCompleteArgValue returns suggestions for a (argument name, argument value prefix) pair.
 // Priority is given to the AutoCompleteFunc from the ArgSpec, if it is set.
-// Otherwise, we use EnumValues from the ArgSpec.
+// Otherwise, we use EnumValuesFunc or EnumValues from the ArgSpec.
 func AutoCompleteArgValue(ctx context.Context, cmd *Command, argSpec *ArgSpec, argValuePrefix string, completedArgs map[string]string) []string {
 	if argSpec == nil {
 		return nil
@@ -380,6 +384,13 @@ func AutoCompleteArgValue(ctx context.Context, cmd *Command, argSpec *ArgSpec, a
 		possibleValues = argSpec.EnumValues
 	}
 
+	if argSpec.EnumValuesFunc != nil {
+		possibleValues = autocompleteEnumValues(ctx, cmd, argSpec, completedArgs)
+		if argSpec.EnumValuesPrefixFunc != nil {
+			argValuePrefix = argSpec.EnumValuesPrefixFunc(argValuePrefix)
+		}
+	}
+
 	// Complete arg value using list verb if possible
 	// "instance server get <tab>" completes "server-id" arg with "id" in instance server list
 	if len(possibleValues) == 0 {
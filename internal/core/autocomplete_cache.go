@@ -0,0 +1,86 @@
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// autocompleteCacheTTL is how long the values listed to complete an argument are reused.
+// Outside of the shell, each completion runs scw again: the cache saves an API call for each [tab].
+var autocompleteCacheTTL = time.Minute
+
+type autocompleteCacheEntry struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Values    []string  `json:"values"`
+}
+
+// autocompleteCacheDir returns the directory caching the values of the completions.
+func autocompleteCacheDir(ctx context.Context) string {
+	return filepath.Join(ExtractCacheDir(ctx), "autocomplete")
+}
+
+// autocompleteCachePath returns the file caching the values of a completion of a namespace for the current profile.
+func autocompleteCachePath(ctx context.Context, namespace string, key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(autocompleteCacheDir(ctx), ExtractProfileName(ctx), namespace, hex.EncodeToString(hash[:])+".json")
+}
+
+// getAutocompleteCachedValues returns the values cached for a completion if they did not expire.
+func getAutocompleteCachedValues(ctx context.Context, namespace string, key string) ([]string, bool) {
+	content, err := os.ReadFile(autocompleteCachePath(ctx, namespace, key))
+	if err != nil {
+		return nil, false
+	}
+	entry := &autocompleteCacheEntry{}
+	if err := json.Unmarshal(content, entry); err != nil || time.Now().After(entry.ExpiresAt) {
+		return nil, false
+	}
+	return entry.Values, true
+}
+
+// setAutocompleteCachedValues caches the values of a completion, failures are only logged
+// as completions must work without a writable cache directory.
+func setAutocompleteCachedValues(ctx context.Context, namespace string, key string, values []string) {
+	content, err := json.Marshal(&autocompleteCacheEntry{
+		ExpiresAt: time.Now().Add(autocompleteCacheTTL),
+		Values:    values,
+	})
+	if err != nil {
+		return
+	}
+
+	path := autocompleteCachePath(ctx, namespace, key)
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err == nil {
+		err = os.WriteFile(path, content, 0600)
+	}
+	if err != nil {
+		ExtractLogger(ctx).Debugf("cannot cache autocomplete values: %s", err)
+	}
+}
+
+// autocompleteCacheClearingVerbs are the verbs of the commands changing resources.
+// The values cached for the completions of their namespace are outdated once they ran.
+var autocompleteCacheClearingVerbs = map[string]bool{
+	"create": true,
+	"update": true,
+	"delete": true,
+}
+
+// clearAutocompleteNamespaceCache removes the values cached for the completions of a namespace for the current profile.
+func clearAutocompleteNamespaceCache(ctx context.Context, namespace string) {
+	err := os.RemoveAll(filepath.Join(autocompleteCacheDir(ctx), ExtractProfileName(ctx), namespace))
+	if err != nil {
+		ExtractLogger(ctx).Debugf("cannot clear autocomplete cache: %s", err)
+	}
+}
+
+// ClearAutocompleteCache removes the values cached for the completions of every profile.
+func ClearAutocompleteCache(ctx context.Context) error {
+	return os.RemoveAll(autocompleteCacheDir(ctx))
+}
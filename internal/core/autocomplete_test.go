@@ -2,6 +2,8 @@ package core_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"strings"
@@ -174,7 +176,7 @@ func TestAutocomplete(t *testing.T) {
 	t.Run("scw test flower create leaves.0.size=", run(&testCase{Suggestions: core.AutocompleteSuggestions{"leaves.0.size=L", "leaves.0.size=M", "leaves.0.size=S", "leaves.0.size=XL", "leaves.0.size=XXL"}}))
 	t.Run("scw -", run(&testCase{Suggestions: core.AutocompleteSuggestions{"--config", "--debug", "--help", "--output", "--profile", "-D", "-c", "-h", "-o", "-p"}}))
 	t.Run("scw test -o j", run(&testCase{Suggestions: core.AutocompleteSuggestions{"json"}}))
-	t.Run("scw test flower -o ", run(&testCase{Suggestions: core.AutocompleteSuggestions{core.PrinterTypeHuman.String(), core.PrinterTypeJSON.String(), core.PrinterTypeTemplate.String(), core.PrinterTypeYAML.String()}}))
+	t.Run("scw test flower -o ", run(&testCase{Suggestions: core.AutocompleteSuggestions{core.PrinterTypeCSV.String(), core.PrinterTypeHuman.String(), core.PrinterTypeIDs.String(), core.PrinterTypeJSON.String(), core.PrinterTypeTemplate.String(), core.PrinterTypeTSV.String(), core.PrinterTypeYAML.String()}}))
 	t.Run("scw test flower -o json create -", run(&testCase{Suggestions: core.AutocompleteSuggestions{"--config", "--debug", "--help", "--output", "--profile", "--wait", "-D", "-c", "-h", "-p", "-w"}}))
 	t.Run("scw test flower create name=p -o j", run(&testCase{Suggestions: core.AutocompleteSuggestions{"json"}}))
 	t.Run("scw test flower create name=p -o json ", run(&testCase{Suggestions: core.AutocompleteSuggestions{"colours.0=", "leaves.", "size=", "species="}}))
@@ -187,7 +189,7 @@ func TestAutocomplete(t *testing.T) {
 	t.Run("scw test flower create name=p --profile xxxx", run(&testCase{Suggestions: nil}))
 
 	t.Run("scw test flower -o json delete -", run(&testCase{Suggestions: core.AutocompleteSuggestions{"--config", "--debug", "--help", "--output", "--profile", "-D", "-c", "-h", "-p"}}))
-	t.Run("scw test flower delete -o ", run(&testCase{Suggestions: core.AutocompleteSuggestions{core.PrinterTypeHuman.String(), core.PrinterTypeJSON.String(), core.PrinterTypeTemplate.String(), core.PrinterTypeYAML.String()}}))
+	t.Run("scw test flower delete -o ", run(&testCase{Suggestions: core.AutocompleteSuggestions{core.PrinterTypeCSV.String(), core.PrinterTypeHuman.String(), core.PrinterTypeIDs.String(), core.PrinterTypeJSON.String(), core.PrinterTypeTemplate.String(), core.PrinterTypeTSV.String(), core.PrinterTypeYAML.String()}}))
 	t.Run("scw test flower delete -o j", run(&testCase{Suggestions: core.AutocompleteSuggestions{"json"}}))
 	t.Run("scw test flower delete -o json ", run(&testCase{Suggestions: core.AutocompleteSuggestions{"anemone", "hibiscus", "with-leaves="}}))
 	t.Run("scw test flower delete -o=json ", run(&testCase{Suggestions: core.AutocompleteSuggestions{"anemone", "hibiscus", "with-leaves="}}))
@@ -258,6 +260,9 @@ func TestAutocompleteArgs(t *testing.T) {
 	ctx := core.InjectMeta(context.Background(), &core.Meta{
 		Commands: commands,
 		BetaMode: true,
+		OverrideEnv: map[string]string{
+			scw.ScwCacheDirEnv: t.TempDir(),
+		},
 	})
 
 	type testCase = autoCompleteTestCase
@@ -272,6 +277,97 @@ func TestAutocompleteArgs(t *testing.T) {
 	t.Run("scw test flower create name=", run(&testCase{Suggestions: core.AutocompleteSuggestions(nil)}))
 }
 
+func TestAutocompleteArgsCache(t *testing.T) {
+	runs := 0
+	commands := testAutocompleteGetCommands()
+	commands.Add(&core.Command{
+		Namespace: "test",
+		Resource:  "flower",
+		Verb:      "get",
+		ArgsType: reflect.TypeOf(struct {
+			FlowerID string
+		}{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name: "flower-id",
+			},
+		},
+	})
+	commands.Add(&core.Command{
+		Namespace: "test",
+		Resource:  "flower",
+		Verb:      "list",
+		ArgsType:  reflect.TypeOf(struct{}{}),
+		ArgSpecs:  core.ArgSpecs{},
+		Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+			runs++
+			return []*struct {
+				ID string
+			}{
+				{
+					ID: "11111111-1111-1111-1111-111111111111",
+				},
+			}, nil
+		},
+	})
+	ctx := core.InjectMeta(context.Background(), &core.Meta{
+		Commands: commands,
+		OverrideEnv: map[string]string{
+			scw.ScwCacheDirEnv: t.TempDir(),
+		},
+	})
+
+	for i := 0; i < 2; i++ {
+		result := core.AutoComplete(ctx, []string{"scw", "test", "flower", "get"}, "flower-id=", nil)
+		assert.Equal(t, core.AutocompleteSuggestions{"flower-id=11111111-1111-1111-1111-111111111111"}, result.Suggestions)
+	}
+	assert.Equal(t, 1, runs)
+}
+
+func TestAutocompleteEnumValuesFunc(t *testing.T) {
+	calls := 0
+	commands := core.NewCommands(&core.Command{
+		Namespace: "test",
+		Resource:  "flower",
+		Verb:      "create",
+		ArgsType: reflect.TypeOf(struct {
+			Species string
+			Zone    scw.Zone
+		}{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name: "species",
+				EnumValuesFunc: func(_ context.Context, _ any) ([]string, error) {
+					calls++
+					return []string{"rose", "violet"}, nil
+				},
+				EnumValuesPrefixFunc: strings.ToLower,
+			},
+			core.ZoneArgSpec(scw.ZoneFrPar1, scw.ZoneFrPar2),
+		},
+	})
+	ctx := core.InjectMeta(context.Background(), &core.Meta{
+		Commands: commands,
+		OverrideEnv: map[string]string{
+			scw.ScwCacheDirEnv: t.TempDir(),
+		},
+	})
+
+	type testCase = autoCompleteTestCase
+
+	run := func(tc *testCase) func(*testing.T) {
+		return runAutocompleteTest(ctx, tc)
+	}
+
+	t.Run("scw test flower create species=", run(&testCase{Suggestions: core.AutocompleteSuggestions{"species=rose", "species=violet"}}))
+	t.Run("scw test flower create species=v", run(&testCase{Suggestions: core.AutocompleteSuggestions{"species=violet"}}))
+	t.Run("scw test flower create species=V", run(&testCase{Suggestions: core.AutocompleteSuggestions{"species=violet"}}))
+	assert.Equal(t, 1, calls)
+
+	t.Run("scw test flower create zone=fr-par-2 species=", run(&testCase{Suggestions: core.AutocompleteSuggestions{"species=rose", "species=violet"}}))
+	assert.Equal(t, 2, calls)
+}
+
 func TestAutocompleteProfiles(t *testing.T) {
 	commands := testAutocompleteGetCommands()
 	ctx := core.InjectMeta(context.Background(), &core.Meta{
@@ -314,3 +410,71 @@ func TestAutocompleteDeprecatedCommand(t *testing.T) {
 
 	t.Run("scw test flower deprecated", run(&testCase{Suggestions: nil}))
 }
+
+func TestAutocompleteCacheInvalidation(t *testing.T) {
+	commands := core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "flower",
+			Verb:                 "delete",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return &core.SuccessResult{}, nil
+			},
+		},
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "flower",
+			Verb:                 "get",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return &core.SuccessResult{}, nil
+			},
+		},
+	)
+	cacheValues := func(ctx *core.BeforeFuncCtx) error {
+		for _, namespace := range []string{"test", "other"} {
+			cachedValues := filepath.Join(ctx.OverrideEnv[scw.ScwCacheDirEnv], "autocomplete", scw.DefaultProfileName, namespace, "values.json")
+			if err := os.MkdirAll(filepath.Dir(cachedValues), 0700); err != nil {
+				return err
+			}
+			if err := os.WriteFile(cachedValues, []byte("{}"), 0600); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	t.Run("Cleared by a command of the namespace", core.Test(&core.TestConfig{
+		Commands:   commands,
+		TmpHomeDir: true,
+		BeforeFunc: cacheValues,
+		Cmd:        "scw test flower delete",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				cacheDir := filepath.Join(ctx.OverrideEnv[scw.ScwCacheDirEnv], "autocomplete", scw.DefaultProfileName)
+				_, err := os.Stat(filepath.Join(cacheDir, "test"))
+				assert.True(t, os.IsNotExist(err))
+				_, err = os.Stat(filepath.Join(cacheDir, "other", "values.json"))
+				assert.NoError(t, err)
+			},
+		),
+	}))
+
+	t.Run("Kept by a read command", core.Test(&core.TestConfig{
+		Commands:   commands,
+		TmpHomeDir: true,
+		BeforeFunc: cacheValues,
+		Cmd:        "scw test flower get",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				_, err := os.Stat(filepath.Join(ctx.OverrideEnv[scw.ScwCacheDirEnv], "autocomplete", scw.DefaultProfileName, "test", "values.json"))
+				assert.NoError(t, err)
+			},
+		),
+	}))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/args"
@@ -12,6 +13,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/strcase"
 )
 
+// autoCompleteCache holds the lists run to complete arguments in the shell, it is nil outside of it.
 var autoCompleteCache *cache.Cache
 
 // getGlobalFlags returns the list of flags that should be added to all commands
@@ -21,6 +23,9 @@ func getGlobalFlags(ctx context.Context) []FlagSpec {
 		PrinterTypeJSON.String(),
 		PrinterTypeYAML.String(),
 		PrinterTypeTemplate.String(),
+		PrinterTypeCSV.String(),
+		PrinterTypeTSV.String(),
+		PrinterTypeIDs.String(),
 	}
 	profiles := []string(nil)
 	cfg := extractConfig(ctx)
@@ -84,10 +89,9 @@ var autocompleteResourceToNamespace = map[string]string{
 	"private-network": "vpc",
 }
 
-// AutocompleteGetArg tries to complete an argument by using the list verb if it exists for the same resource
-// It will search for the same field in the response of the list
-// Field name will be stripped of the resource name (ex: cluster-id -> id)
-func AutocompleteGetArg(ctx context.Context, cmd *Command, argSpec *ArgSpec, completedArgs map[string]string) []string {
+// findArgListCommand returns the list command of the resource an argument refers to
+// and the field holding the argument in the listed resources, or nil if the resource has no list command.
+func findArgListCommand(ctx context.Context, cmd *Command, argSpec *ArgSpec) (*Command, string) {
 	commands := ExtractCommands(ctx)
 
 	// The argument we want to find (ex: server-id)
@@ -109,7 +113,7 @@ func AutocompleteGetArg(ctx context.Context, cmd *Command, argSpec *ArgSpec, com
 	// does not complete name in "scw instance server create name=<tab>"
 	// but still complete for different resources ex: "scw container container create namespace-id=<tab>"
 	if cmd.Verb == "create" && argResource == cmd.Resource {
-		return nil
+		return nil, ""
 	}
 
 	// remove resource from arg name (ex: server-id -> id)
@@ -124,10 +128,22 @@ func AutocompleteGetArg(ctx context.Context, cmd *Command, argSpec *ArgSpec, com
 			listCmd, hasList = commands.find(namespace, argResource, "list")
 		}
 		if !hasList {
-			return nil
+			return nil, ""
 		}
 	}
 
+	return listCmd, argName
+}
+
+// AutocompleteGetArg tries to complete an argument by using the list verb if it exists for the same resource
+// It will search for the same field in the response of the list
+// Field name will be stripped of the resource name (ex: cluster-id -> id)
+func AutocompleteGetArg(ctx context.Context, cmd *Command, argSpec *ArgSpec, completedArgs map[string]string) []string {
+	listCmd, argName := findArgListCommand(ctx, cmd, argSpec)
+	if listCmd == nil {
+		return nil
+	}
+
 	// Build empty arguments and run command
 	// Has to use interceptor if it exists as ArgsType could be handled by interceptor
 	listCmdArgs := reflect.New(listCmd.ArgsType).Interface()
@@ -152,7 +168,18 @@ func AutocompleteGetArg(ctx context.Context, cmd *Command, argSpec *ArgSpec, com
 		}
 	}
 
+	// Sort arguments as the command is used as a cache key
+	sort.Strings(listRawArgs)
 	rawCommand := fmt.Sprintf("%s %s", listCmd.getPath(), strings.Join(listRawArgs, " "))
+
+	// Outside of the shell, values are cached on disk to be reused by the next completions
+	cacheKey := rawCommand + " " + argName
+	if autoCompleteCache == nil {
+		if values, cached := getAutocompleteCachedValues(ctx, listCmd.Namespace, cacheKey); cached {
+			return values
+		}
+	}
+
 	resp := autoCompleteCache.Get(rawCommand)
 	if resp == nil {
 		resp, err = listCmd.Interceptor(ctx, listCmdArgs, listCmd.Run)
@@ -181,6 +208,43 @@ func AutocompleteGetArg(ctx context.Context, cmd *Command, argSpec *ArgSpec, com
 		}
 	}
 
+	if autoCompleteCache == nil {
+		setAutocompleteCachedValues(ctx, listCmd.Namespace, cacheKey, values)
+	}
+
+	return values
+}
+
+// autocompleteEnumValues lists the possible values of an argument with its EnumValuesFunc.
+// Values are cached by command, argument and locality.
+func autocompleteEnumValues(ctx context.Context, cmd *Command, argSpec *ArgSpec, completedArgs map[string]string) []string {
+	localities := []string(nil)
+	for arg, value := range completedArgs {
+		if strings.HasPrefix(arg, "zone") || strings.HasPrefix(arg, "region") {
+			localities = append(localities, arg+value)
+		}
+	}
+	sort.Strings(localities)
+	cacheKey := strings.Join(append([]string{cmd.getPath(), argSpec.Name}, localities...), " ")
+
+	if autoCompleteCache == nil {
+		if values, cached := getAutocompleteCachedValues(ctx, cmd.Namespace, cacheKey); cached {
+			return values
+		}
+	} else if values, cached := autoCompleteCache.Get(cacheKey).([]string); cached {
+		return values
+	}
+
+	values, err := argSpec.EnumValuesFunc(ctx, requestFromCompletedArgs(cmd, completedArgs))
+	if err != nil {
+		return nil
+	}
+
+	if autoCompleteCache == nil {
+		setAutocompleteCachedValues(ctx, cmd.Namespace, cacheKey, values)
+	} else {
+		autoCompleteCache.Set(cacheKey, values)
+	}
 	return values
 }
 
@@ -2,15 +2,20 @@ package core
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/account"
 	cliConfig "github.com/scaleway/scaleway-cli/v2/internal/config"
 	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
 	"github.com/scaleway/scaleway-cli/v2/internal/platform"
+	"github.com/scaleway/scaleway-cli/v2/internal/sentry"
+	"github.com/scaleway/scaleway-cli/v2/internal/terminal"
 	"github.com/scaleway/scaleway-sdk-go/logger"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/spf13/cobra"
@@ -40,13 +45,17 @@ type BootstrapConfig struct {
 	// If not a client will be automatically created by the CLI using Config, Env and flags see createClient().
 	Client *scw.Client
 
-	// DisableTelemetry, if set to true this will disable telemetry report no matter what the config send_telemetry is set to.
+	// DisableTelemetry, if set to true this will disable telemetry report no matter what the config send_usage and send_crash_reports are set to.
 	// This is useful when running test to avoid sending meaningless telemetries.
 	DisableTelemetry bool
 
 	// DisableAliases, if set to true this will disable aliases expanding
 	DisableAliases bool
 
+	// AssumeYes, if set to true destructive commands run without confirmation as with the -y flag.
+	// This is useful when running tests that do not mock the answer of the confirmation prompt.
+	AssumeYes bool
+
 	// OverrideEnv overrides environment variables returned by core.ExtractEnv function.
 	// This is useful for tests as it allows overriding env without relying on global state.
 	OverrideEnv map[string]string
@@ -83,12 +92,30 @@ func Bootstrap(config *BootstrapConfig) (exitCode int, result interface{}, err e
 	var profileFlag string
 	var configPathFlag string
 	var outputFlag string
+	var queryFlag string
+	var colorFlag string
+	var noPagerFlag bool
+	var assumeYesFlag bool
+	var dryRunFlag bool
+	var timeoutFlag time.Duration
+	var caFileFlag string
+	var insecureSkipVerifyFlag bool
+	var dumpCommandsSchemaFlag bool
 
 	flags := pflag.NewFlagSet(config.Args[0], pflag.ContinueOnError)
 	flags.StringVarP(&profileFlag, "profile", "p", "", "The config profile to use")
 	flags.StringVarP(&configPathFlag, "config", "c", "", "The path to the config file")
 	flags.StringVarP(&outputFlag, "output", "o", cliConfig.DefaultOutput, "Output format: json or human")
+	flags.StringVar(&queryFlag, "query", "", "JMESPath query to filter the output")
+	flags.StringVar(&colorFlag, "color", terminal.ColorAuto, "Color mode: auto, always or never")
+	flags.BoolVar(&noPagerFlag, "no-pager", false, "Do not pipe long human output into a pager")
+	flags.BoolVarP(&assumeYesFlag, "assume-yes", "y", false, "Run destructive commands without confirmation")
+	flags.BoolVar(&dryRunFlag, "dry-run", false, "Print the API request instead of sending it")
+	flags.DurationVar(&timeoutFlag, "timeout", 0, "Timeout of API requests and waits")
+	flags.StringVar(&caFileFlag, "ca-file", "", "PEM file of additional trusted certificates")
+	flags.BoolVar(&insecureSkipVerifyFlag, "insecure-skip-verify", false, "Do not verify TLS certificates")
 	flags.BoolVarP(&debug, "debug", "D", os.Getenv("SCW_DEBUG") == "true", "Enable debug mode")
+	flags.BoolVar(&dumpCommandsSchemaFlag, "dump-commands-schema", false, "Print the schema of all commands as JSON")
 	// Ignore unknown flag
 	flags.ParseErrorsWhitelist.UnknownFlags = true
 	// Make sure usage is never print by the parse method. (It should only be print by cobra)
@@ -124,11 +151,32 @@ func Bootstrap(config *BootstrapConfig) (exitCode int, result interface{}, err e
 	logger.SetLogger(log)
 	log.Debugf("running: %s\n", config.Args)
 
+	// The schema describes the commands themselves, it does not need a config nor a client
+	if dumpCommandsSchemaFlag {
+		schema := config.Commands.GetSchema(config.Args[0])
+		encoder := json.NewEncoder(config.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(schema)
+		if err != nil {
+			return 1, nil, err
+		}
+		return 0, schema, nil
+	}
+
+	// Colors must be set before anything is printed
+	err = terminal.SetColorMode(colorFlag)
+	if err != nil {
+		_, _ = fmt.Fprintln(config.Stderr, err)
+		return 1, nil, err
+	}
+
 	// The printer must be the first thing set in order to print errors
 	printer, err := NewPrinter(&PrinterConfig{
-		OutputFlag: outputFlag,
-		Stdout:     config.Stdout,
-		Stderr:     config.Stderr,
+		OutputFlag:  outputFlag,
+		QueryFlag:   queryFlag,
+		EnablePager: !noPagerFlag,
+		Stdout:      config.Stdout,
+		Stderr:      config.Stderr,
 	})
 	if err != nil {
 		_, _ = fmt.Fprintln(config.Stderr, err)
@@ -137,12 +185,29 @@ func Bootstrap(config *BootstrapConfig) (exitCode int, result interface{}, err e
 	interactive.SetOutputWriter(config.Stderr) // set printer for interactive function (always stderr).
 
 	httpClient := config.HTTPClient
+	var retryTransport *retryableHTTPTransport
 	if httpClient == nil {
+		retryTransport = newRetryableHTTPTransport(&SocketPassthroughTransport{})
 		httpClient = &http.Client{
-			Transport: &retryableHTTPTransport{transport: &SocketPassthroughTransport{}},
+			Transport: retryTransport,
 		}
+	} else {
+		// The client given in the config is shared with the SDK client of the config, so the transports of this run are set on it.
+		// A copy of it is restored once the run is done, so the next runs do not wrap them again.
+		initialHTTPClient := *httpClient
+		defer func() {
+			*httpClient = initialHTTPClient
+		}()
 	}
 
+	// Failed responses are recorded so errors can show their request ID.
+	failedResponses := &failedResponseRecorder{}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpClient.Transport = &failedResponseTransport{transport: transport, recorder: failedResponses}
+
 	// An authenticated client will be created later if required.
 	client := config.Client
 	isClientFromBootstrapConfig := true
@@ -157,6 +222,7 @@ func Bootstrap(config *BootstrapConfig) (exitCode int, result interface{}, err e
 			return 1, nil, err
 		}
 	}
+	registerClientSecret(client)
 
 	// Meta store globally available variables like SDK client.
 	// Meta is injected in a context object that will be passed to all commands.
@@ -175,9 +241,13 @@ func Bootstrap(config *BootstrapConfig) (exitCode int, result interface{}, err e
 		stdout:                      config.Stdout,
 		stderr:                      config.Stderr,
 		stdin:                       config.Stdin,
+		printer:                     printer,
 		result:                      nil, // result is later injected by cobra_utils.go/cobraRun()
 		command:                     nil, // command is later injected by cobra_utils.go/cobraRun()
 		httpClient:                  httpClient,
+		progressBus:                 &progressBus{},
+		failedResponses:             failedResponses,
+		dryRun:                      dryRunFlag,
 		isClientFromBootstrapConfig: isClientFromBootstrapConfig,
 		BetaMode:                    config.BetaMode,
 	}
@@ -198,6 +268,21 @@ func Bootstrap(config *BootstrapConfig) (exitCode int, result interface{}, err e
 	ctx = account.InjectHTTPClient(ctx, httpClient)
 	ctx = InjectMeta(ctx, meta)
 
+	if debug {
+		// The trace can then be attached to a bug report with `scw feedback bug`
+		defer recordDebugTrace(ctx, log, config.Args)()
+
+		transport := httpClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		httpClient.Transport = &debugHTTPTransport{
+			transport: transport,
+			logger:    log,
+			curl:      ExtractEnv(ctx, debugCurlEnv) == "true",
+		}
+	}
+
 	// Load CLI config
 	cliCfg, err := cliConfig.LoadConfig(ExtractCliConfigPath(ctx))
 	if err != nil {
@@ -208,17 +293,75 @@ func Bootstrap(config *BootstrapConfig) (exitCode int, result interface{}, err e
 		return 1, nil, err
 	}
 	meta.CliConfig = cliCfg
-	if cliCfg.Output != cliConfig.DefaultOutput {
-		outputFlag = cliCfg.Output
+	meta.assumeYes = config.AssumeYes || assumeYesFlag || cliCfg.AssumeYes
+	// The command line is attached to crash reports as usage, both can be disabled independently
+	meta.sendUsage = !config.DisableTelemetry && cliCfg.IsTelemetryEnabled(cliConfig.TelemetryUsage)
+	sentry.SetReportsEnabled(!config.DisableTelemetry && cliCfg.IsTelemetryEnabled(cliConfig.TelemetryCrashReport))
+	if retryTransport != nil && cliCfg.MaxRetries != nil {
+		retryTransport.maxRetries = *cliCfg.MaxRetries
+	}
+	// Proxies inspecting TLS traffic need their own certificates to be trusted
+	caFile := caFileFlag
+	if caFile == "" {
+		caFile = cliCfg.CAFile
+	}
+	insecureSkipVerify := insecureSkipVerifyFlag || cliCfg.InsecureSkipVerify
+	if retryTransport == nil && (caFile != "" || insecureSkipVerify) {
+		// The transport of a client given in the config is not known, its TLS config cannot be changed
+		err := &CliError{
+			Err:  errors.New("the ca_file and insecure_skip_verify TLS options cannot be used with the HTTP client given to the CLI"),
+			Hint: "configure the TLS settings of the HTTP client instead",
+		}
+		printErr := printer.Print(err, nil)
+		if printErr != nil {
+			_, _ = fmt.Fprintln(config.Stderr, printErr)
+		}
+		return 1, nil, err
+	}
+	if caFile != "" || insecureSkipVerify {
+		tlsTransport, err := newTLSTransport(caFile, insecureSkipVerify)
+		if err != nil {
+			printErr := printer.Print(err, nil)
+			if printErr != nil {
+				_, _ = fmt.Fprintln(config.Stderr, printErr)
+			}
+			return 1, nil, err
+		}
+		retryTransport.transport = &SocketPassthroughTransport{transport: tlsTransport}
+		if insecureSkipVerify {
+			log.Warningf("TLS certificates are not verified, the connection to the API is not secure\n")
+		}
+	}
+
+	// The timeout from the config is only used when no timeout flag is given
+	meta.timeout = timeoutFlag
+	if !flags.Changed("timeout") {
+		meta.timeout = cliCfg.ProfileRequestTimeout(ExtractProfileName(ctx))
+	}
+	if meta.timeout > 0 {
+		httpClient.Timeout = meta.timeout
+	}
+	// The output format from the config is only used when no output flag is given
+	configOutput := cliCfg.ProfileOutput(ExtractProfileName(ctx))
+	if !flags.Changed("output") && configOutput != cliConfig.DefaultOutput {
+		outputFlag = configOutput
 		printer, err = NewPrinter(&PrinterConfig{
-			OutputFlag: outputFlag,
-			Stdout:     config.Stdout,
-			Stderr:     config.Stderr,
+			OutputFlag:  outputFlag,
+			QueryFlag:   queryFlag,
+			EnablePager: !noPagerFlag,
+			Stdout:      config.Stdout,
+			Stderr:      config.Stderr,
 		})
 		if err != nil {
 			_, _ = fmt.Fprintln(config.Stderr, err)
 			return 1, nil, err
 		}
+		meta.printer = printer
+	}
+
+	// JSON output is meant for machines, progress of long-running operations is reported on stderr as JSON lines
+	if printer.printerType == PrinterTypeJSON {
+		meta.progressBus.subscribe(jsonLinesProgressWriter(config.Stderr))
 	}
 
 	// Run checks after command has been executed
@@ -257,28 +400,52 @@ func Bootstrap(config *BootstrapConfig) (exitCode int, result interface{}, err e
 	rootCmd.PersistentFlags().StringVarP(&profileFlag, "profile", "p", "", "The config profile to use")
 	rootCmd.PersistentFlags().StringVarP(&configPathFlag, "config", "c", "", "The path to the config file")
 	rootCmd.PersistentFlags().StringVarP(&outputFlag, "output", "o", cliConfig.DefaultOutput, "Output format: json or human, see 'scw help output' for more info")
+	rootCmd.PersistentFlags().StringVar(&queryFlag, "query", "", "JMESPath query to filter the output, see 'scw help output' for more info")
+	rootCmd.PersistentFlags().BoolVar(&noPagerFlag, "no-pager", false, "Do not pipe long human output into a pager, the PAGER env variable defaults to 'less -R'")
+	rootCmd.PersistentFlags().StringVar(&colorFlag, "color", terminal.ColorAuto, "Color mode: auto, always or never, auto disables colors when NO_COLOR is set or output is not a terminal")
+	rootCmd.PersistentFlags().BoolVarP(&assumeYesFlag, "assume-yes", "y", false, "Run destructive commands without asking for confirmation")
+	rootCmd.PersistentFlags().BoolVar(&dryRunFlag, "dry-run", false, "Print the first API request instead of sending any")
+	rootCmd.PersistentFlags().DurationVar(&timeoutFlag, "timeout", 0, "Timeout of each API request and of the wait of --wait, e.g. 30s or 5m")
+	rootCmd.PersistentFlags().StringVar(&caFileFlag, "ca-file", "", "PEM file of certificates to trust on top of the system ones, e.g. for a proxy inspecting TLS traffic")
+	rootCmd.PersistentFlags().BoolVar(&insecureSkipVerifyFlag, "insecure-skip-verify", false, "Do not verify TLS certificates, this is insecure and should only be used for testing")
 	rootCmd.PersistentFlags().BoolVarP(&debug, "debug", "D", false, "Enable debug mode")
+	rootCmd.PersistentFlags().BoolVar(&dumpCommandsSchemaFlag, "dump-commands-schema", false, "Print the schema of all commands as JSON, for third-party tools")
+	_ = rootCmd.PersistentFlags().MarkHidden("dump-commands-schema")
+
+	// Commands unknown to the CLI may be provided by a plugin
+	if pluginPath, pluginArgs := findPlugin(ctx, rootCmd, args); pluginPath != "" {
+		exitCode, err := runPlugin(ctx, pluginPath, pluginArgs)
+		if err != nil {
+			printErr := printer.Print(err, nil)
+			if printErr != nil {
+				_, _ = fmt.Fprintln(config.Stderr, printErr)
+			}
+			return 1, nil, err
+		}
+		return exitCode, nil, nil
+	}
+
 	rootCmd.SetArgs(args)
 	rootCmd.SetHelpCommand(&cobra.Command{Hidden: true})
 	err = rootCmd.Execute()
 
 	if err != nil {
 		if _, ok := err.(*interactive.InterruptError); ok {
-			return 130, nil, err
-		}
-		errorCode := 1
-		if cliErr, ok := err.(*CliError); ok && cliErr.Code != 0 {
-			errorCode = cliErr.Code
+			return ExitCodeInterrupted, nil, err
 		}
 		printErr := printer.Print(err, nil)
 		if printErr != nil {
 			_, _ = fmt.Fprintln(os.Stderr, err)
 		}
-		return errorCode, nil, err
+		return ExitCode(err), nil, err
 	}
 
 	if meta.command != nil {
-		printErr := printer.Print(meta.result, meta.command.getHumanMarshalerOpt())
+		if autocompleteCacheClearingVerbs[meta.command.Verb] {
+			clearAutocompleteNamespaceCache(ctx, meta.command.Namespace)
+		}
+
+		printErr := printer.Print(meta.result, humanMarshalerOpt(meta, meta.command))
 		if printErr != nil {
 			_, _ = fmt.Fprintln(config.Stderr, printErr)
 		}
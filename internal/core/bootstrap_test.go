@@ -2,7 +2,11 @@ package core_test
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
@@ -154,3 +158,104 @@ func TestInterruptError(t *testing.T) {
 		},
 	}))
 }
+
+func TestConfigOutput(t *testing.T) {
+	type Human struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	commands := core.NewCommands(
+		&core.Command{
+			Namespace: "get",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return &Human{
+					ID:   "111111111-111111111",
+					Name: "David Copperfield",
+				}, nil
+			},
+		},
+	)
+
+	writeCliConfig := func(ctx *core.BeforeFuncCtx) error {
+		configDir := filepath.Join(ctx.Meta["HOME"].(string), ".config", "scw")
+		err := os.MkdirAll(configDir, 0700)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(configDir, "cli.yaml"), []byte("output: yaml\nprofiles_output:\n  ci: json\n"), 0600)
+	}
+
+	t.Run("global", core.Test(&core.TestConfig{
+		Commands:   commands,
+		TmpHomeDir: true,
+		BeforeFunc: writeCliConfig,
+		Cmd:        "scw get",
+		Check:      core.TestCheckGolden(),
+	}))
+
+	t.Run("profile", core.Test(&core.TestConfig{
+		Commands:   commands,
+		TmpHomeDir: true,
+		BeforeFunc: writeCliConfig,
+		Cmd:        "scw -p ci get",
+		Check:      core.TestCheckGolden(),
+	}))
+
+	t.Run("flag", core.Test(&core.TestConfig{
+		Commands:   commands,
+		TmpHomeDir: true,
+		BeforeFunc: writeCliConfig,
+		Cmd:        "scw -p ci get -o human",
+		Check:      core.TestCheckGolden(),
+	}))
+}
+
+func TestColorFlag(t *testing.T) {
+	commands := core.NewCommands(
+		&core.Command{
+			Namespace: "test",
+			Resource:  "color",
+			Verb:      "error",
+			ArgsType:  reflect.TypeOf(args.RawArgs{}),
+			Run: func(_ context.Context, _ interface{}) (i interface{}, e error) {
+				return nil, fmt.Errorf("colored error")
+			},
+		},
+	)
+
+	hasColors := func(expected bool) core.TestCheck {
+		return func(t *testing.T, ctx *core.CheckFuncCtx) {
+			assert.Equal(t, expected, strings.Contains(string(ctx.Stderr), "\x1b["), "stderr: %q", ctx.Stderr)
+		}
+	}
+
+	// Colors are a global setting, tests must not run in parallel
+	t.Run("always", core.Test(&core.TestConfig{
+		Commands:        commands,
+		DisableParallel: true,
+		Cmd:             "scw test color error --color=always",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			hasColors(true),
+		),
+	}))
+
+	t.Run("never", core.Test(&core.TestConfig{
+		Commands:        commands,
+		DisableParallel: true,
+		Cmd:             "scw test color error --color=never",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			hasColors(false),
+		),
+	}))
+
+	t.Run("invalid", core.Test(&core.TestConfig{
+		Commands:        commands,
+		DisableParallel: true,
+		Cmd:             "scw test color error --color=rainbow",
+		Check:           core.TestCheckExitCode(1),
+	}))
+}
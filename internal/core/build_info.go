@@ -62,8 +62,14 @@ func (b *BuildInfo) Tags() map[string]string {
 	}
 }
 
+// CanCheckVersion returns whether the CLI may look for a newer release:
+// only official releases are checked and users can opt out with SCW_DISABLE_CHECK_VERSION.
+func (b *BuildInfo) CanCheckVersion(ctx context.Context) bool {
+	return b.IsRelease() && ExtractEnv(ctx, scwDisableCheckVersionEnv) != "true"
+}
+
 func (b *BuildInfo) checkVersion(ctx context.Context) {
-	if !b.IsRelease() || ExtractEnv(ctx, scwDisableCheckVersionEnv) == "true" {
+	if !b.CanCheckVersion(ctx) {
 		ExtractLogger(ctx).Debug("skipping check version")
 		return
 	}
@@ -82,6 +88,11 @@ func (b *BuildInfo) checkVersion(ctx context.Context) {
 	}
 }
 
+// GetLatestVersion returns the version of the latest release of the CLI.
+func GetLatestVersion(ctx context.Context) (*version.Version, error) {
+	return getLatestVersion(ExtractHTTPClient(ctx))
+}
+
 // getLatestVersion attempt to read the latest version of the remote file at latestVersionFileURL.
 func getLatestVersion(client *http.Client) (*version.Version, error) {
 	ctx, cancelTimeout := context.WithTimeout(context.Background(), latestVersionRequestTimeout)
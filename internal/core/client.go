@@ -1,10 +1,12 @@
 package core
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/platform"
+	"github.com/scaleway/scaleway-cli/v2/internal/redact"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
@@ -24,6 +26,48 @@ func createAnonymousClient(httpClient *http.Client, buildInfo *BuildInfo) (*scw.
 	return client, nil
 }
 
+// registerClientSecret makes the secret key of the client masked in the logs.
+func registerClientSecret(client *scw.Client) {
+	if secretKey, exists := client.GetSecretKey(); exists {
+		redact.Register(secretKey)
+	}
+}
+
+// UseAPIURL replaces the client of the running command by a client sending its requests to apiURL,
+// e.g. to check credentials against the endpoint of the profile being created.
+// The other settings of the current client, resolved from the profile, are kept.
+func UseAPIURL(ctx context.Context, apiURL string) error {
+	meta := extractMeta(ctx)
+	opts := []scw.ClientOption{
+		scw.WithUserAgent(meta.BuildInfo.GetUserAgent()),
+		scw.WithHTTPClient(meta.httpClient),
+		scw.WithAPIURL(apiURL),
+	}
+	if region, exists := meta.Client.GetDefaultRegion(); exists {
+		opts = append(opts, scw.WithDefaultRegion(region))
+	}
+	if zone, exists := meta.Client.GetDefaultZone(); exists {
+		opts = append(opts, scw.WithDefaultZone(zone))
+	}
+	if organizationID, exists := meta.Client.GetDefaultOrganizationID(); exists {
+		opts = append(opts, scw.WithDefaultOrganizationID(organizationID))
+	}
+	if projectID, exists := meta.Client.GetDefaultProjectID(); exists {
+		opts = append(opts, scw.WithDefaultProjectID(projectID))
+	}
+	if accessKey, exists := meta.Client.GetAccessKey(); exists {
+		secretKey, _ := meta.Client.GetSecretKey()
+		opts = append(opts, scw.WithAuth(accessKey, secretKey))
+	}
+
+	client, err := scw.NewClient(opts...)
+	if err != nil {
+		return err
+	}
+	meta.Client = client
+	return nil
+}
+
 func createClientError(err error) error {
 	credentialsHint := "You can get your credentials here: https://console.scaleway.com/iam/api-keys"
 
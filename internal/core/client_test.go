@@ -0,0 +1,47 @@
+package core_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func Test_UseAPIURL(t *testing.T) {
+	client, err := scw.NewClient(
+		scw.WithDefaultRegion(scw.RegionNlAms),
+		scw.WithDefaultZone(scw.ZoneNlAms2),
+		scw.WithAuth("SCWXXXXXXXXXXXXXXXXX", "11111111-1111-1111-1111-111111111111"),
+	)
+	assert.NoError(t, err)
+
+	t.Run("Profile settings kept", core.Test(&core.TestConfig{
+		Commands: core.NewCommands(&core.Command{
+			Namespace:            "test",
+			Resource:             "client",
+			Verb:                 "get",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+				err := core.UseAPIURL(ctx, "https://api.example.com")
+				if err != nil {
+					return nil, err
+				}
+				zone, _ := core.ExtractClient(ctx).GetDefaultZone()
+				return zone.String(), nil
+			},
+		}),
+		Client: client,
+		Cmd:    "scw test client get",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				t.Helper()
+				assert.Equal(t, "nl-ams-2", ctx.Result)
+			},
+		),
+	}))
+}
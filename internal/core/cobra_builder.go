@@ -46,6 +46,9 @@ func (b *cobraBuilder) build() *cobra.Command {
 
 		// Do not display usage on error.
 		SilenceUsage: true,
+
+		// Let unknown commands be reported with our own suggestions.
+		Args: cobra.ArbitraryArgs,
 	}
 
 	// Disable autocomplete commands from Cobra we should study whether or not we could use instead of our own logic
@@ -177,9 +180,22 @@ func (b *cobraBuilder) hydrateCobra(cobraCmd *cobra.Command, cmd *Command, group
 		cobraCmd.Deprecated = "Deprecated:"
 	}
 
+	if commandIsPaginated(cmd) {
+		addPaginationFlags(cobraCmd)
+	}
+
+	if commandIsList(cmd) {
+		addFilterFlag(cobraCmd)
+		addSortFlag(cobraCmd)
+	}
+
 	if commandHasWeb(cmd) {
 		cobraCmd.PersistentFlags().Bool("web", false, "open console page for the current ressource")
 	}
+
+	if commandHasWatch(cmd) {
+		addWatchFlag(cobraCmd)
+	}
 }
 
 const usageTemplate = `USAGE:
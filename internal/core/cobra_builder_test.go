@@ -38,6 +38,24 @@ func Test_UnknownCommand(t *testing.T) {
 			core.TestCheckExitCode(1),
 		),
 	}))
+
+	t.Run("MistypedNamespace", core.Test(&core.TestConfig{
+		Commands: cmds,
+		Cmd:      "scw instanse server",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+	}))
+
+	t.Run("MistypedResource", core.Test(&core.TestConfig{
+		Commands: cmds,
+		Cmd:      "scw instance serveur list",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+	}))
 }
 
 func Test_DeprecatedCommand(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/args"
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
 	"github.com/scaleway/scaleway-cli/v2/internal/sentry"
 	"github.com/spf13/cobra"
 )
@@ -15,20 +16,30 @@ import (
 // cobraRun returns a cobraRun command that wrap a CommandRunner function.
 func cobraRun(ctx context.Context, cmd *Command) func(*cobra.Command, []string) error {
 	return func(cobraCmd *cobra.Command, rawArgsStr []string) error {
-		rawArgs := args.RawArgs(rawArgsStr)
-
 		meta := extractMeta(ctx)
 		meta.command = cmd
 
-		sentry.AddCommandContext(cmd.GetCommandLine("scw"))
+		if dash := cobraCmd.ArgsLenAtDash(); cmd.AcceptPassthroughArgs && dash >= 0 {
+			// Copy the arguments as default values are later appended to the raw args
+			meta.passthroughArgs = append([]string(nil), rawArgsStr[dash:]...)
+			rawArgsStr = rawArgsStr[:dash:dash]
+		}
+		rawArgs := args.RawArgs(rawArgsStr)
+
+		if meta.sendUsage && !cmd.DisableTelemetry {
+			sentry.AddCommandContext(cmd.GetCommandLine("scw"))
+		}
 
 		// If command requires authentication and the client was not directly provided in the bootstrap config, we create a new client and overwrite the existing one
 		if !cmd.AllowAnonymousClient && !meta.isClientFromBootstrapConfig {
-			client, err := meta.Platform.CreateClient(meta.httpClient, ExtractConfigPath(ctx), ExtractProfileName(ctx))
+			client, err := meta.Platform.CreateClient(ctx, meta.httpClient, ExtractConfigPath(ctx), ExtractProfileName(ctx), func(key string) string {
+				return ExtractEnv(ctx, key)
+			})
 			if err != nil {
 				return createClientError(err)
 			}
 			meta.Client = client
+			registerClientSecret(client)
 		}
 
 		// If command has no Run method there is nothing to do.
@@ -36,81 +47,107 @@ func cobraRun(ctx context.Context, cmd *Command) func(*cobra.Command, []string)
 			return nil
 		}
 
-		// Apply default values on missing args.
-		rawArgs = ApplyDefaultValues(ctx, cmd.ArgSpecs, rawArgs)
+		watchInterval, err := getWatchIntervalFromFlags(cobraCmd, cmd)
+		if err != nil {
+			return err
+		}
+		if watchInterval > 0 {
+			return runWatch(ctx, cmd, watchInterval, func() (interface{}, error) {
+				return runCommand(ctx, cobraCmd, cmd, rawArgs)
+			})
+		}
+
+		result, err := runCommand(ctx, cobraCmd, cmd, rawArgs)
+		if err != nil {
+			return err
+		}
+
+		meta.result = result
+		return nil
+	}
+}
 
-		positionalArgSpec := cmd.ArgSpecs.GetPositionalArg()
+// runCommand runs the command once for each positional argument and returns its result.
+func runCommand(ctx context.Context, cobraCmd *cobra.Command, cmd *Command, rawArgs args.RawArgs) (interface{}, error) {
+	meta := extractMeta(ctx)
 
-		// If this command has no positional argument we execute the run
-		if positionalArgSpec == nil {
-			data, err := run(ctx, cobraCmd, cmd, rawArgs)
-			if err != nil {
-				return err
-			}
+	// Apply default values on missing args.
+	rawArgs = ApplyDefaultValues(ctx, cmd.ArgSpecs, rawArgs)
 
-			meta.result = data
-			return nil
+	// Let the user select the missing resources instead of failing
+	rawArgs, err := pickMissingResourceIDs(ctx, cmd, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	positionalArgSpec := cmd.ArgSpecs.GetPositionalArg()
+
+	// If this command has no positional argument we execute the run
+	if positionalArgSpec == nil {
+		return run(ctx, cobraCmd, cmd, rawArgs)
+	}
+
+	positionalArgs := rawArgs.GetPositionalArgs()
+
+	// Return an error if a positional argument was provide using `key=value` syntax.
+	value, exist := rawArgs.Get(positionalArgSpec.Name)
+	if exist {
+		otherArgs := rawArgs.Remove(positionalArgSpec.Name)
+		return nil, &CliError{
+			Err:  fmt.Errorf("a positional argument is required for this command"),
+			Hint: positionalArgHint(meta.BinaryName, cmd, value, otherArgs, len(positionalArgs) > 0),
 		}
+	}
 
-		positionalArgs := rawArgs.GetPositionalArgs()
+	// Commands selecting their resources with other arguments can be run without positional arguments
+	if len(positionalArgs) == 0 && cmd.AcceptMultiplePositionalArgs && cmd.AcceptNoPositionalArgs {
+		return run(ctx, cobraCmd, cmd, rawArgs)
+	}
 
-		// Return an error if a positional argument was provide using `key=value` syntax.
-		value, exist := rawArgs.Get(positionalArgSpec.Name)
-		if exist {
-			otherArgs := rawArgs.Remove(positionalArgSpec.Name)
-			return &CliError{
-				Err:  fmt.Errorf("a positional argument is required for this command"),
-				Hint: positionalArgHint(meta.BinaryName, cmd, value, otherArgs, len(positionalArgs) > 0),
-			}
+	// If no positional arguments were provided, return an error
+	if len(positionalArgs) == 0 {
+		return nil, &CliError{
+			Err:  fmt.Errorf("a positional argument is required for this command"),
+			Hint: positionalArgHint(meta.BinaryName, cmd, "<"+positionalArgSpec.Name+">", rawArgs, false),
 		}
+	}
 
-		// If no positional arguments were provided, return an error
-		if len(positionalArgs) == 0 {
-			return &CliError{
-				Err:  fmt.Errorf("a positional argument is required for this command"),
-				Hint: positionalArgHint(meta.BinaryName, cmd, "<"+positionalArgSpec.Name+">", rawArgs, false),
-			}
+	results := MultiResults(nil)
+	rawArgs = rawArgs.RemoveAllPositional()
+
+	if cmd.AcceptMultiplePositionalArgs {
+		argNameWithIndex := fmt.Sprintf("%s.%d", positionalArgSpec.Name, 0)
+		rawArgsWithPositional := rawArgs.Add(argNameWithIndex, positionalArgs[0])
+		for i := 1; i < len(positionalArgs); i++ {
+			argNameWithIndex = fmt.Sprintf("%s.%d", positionalArgSpec.Name, i)
+			rawArgsWithPositional = rawArgsWithPositional.Add(argNameWithIndex, positionalArgs[i])
 		}
 
-		results := MultiResults(nil)
-		rawArgs = rawArgs.RemoveAllPositional()
+		result, err := run(ctx, cobraCmd, cmd, rawArgsWithPositional)
+		if err != nil {
+			return nil, err
+		}
 
-		if cmd.AcceptMultiplePositionalArgs {
-			argNameWithIndex := fmt.Sprintf("%s.%d", positionalArgSpec.Name, 0)
-			rawArgsWithPositional := rawArgs.Add(argNameWithIndex, positionalArgs[0])
-			for i := 1; i < len(positionalArgs); i++ {
-				argNameWithIndex = fmt.Sprintf("%s.%d", positionalArgSpec.Name, i)
-				rawArgsWithPositional = rawArgsWithPositional.Add(argNameWithIndex, positionalArgs[i])
-			}
+		results = append(results, result)
+	} else {
+		for _, positionalArg := range positionalArgs {
+			rawArgsWithPositional := rawArgs.Add(positionalArgSpec.Name, positionalArg)
 
 			result, err := run(ctx, cobraCmd, cmd, rawArgsWithPositional)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			results = append(results, result)
-		} else {
-			for _, positionalArg := range positionalArgs {
-				rawArgsWithPositional := rawArgs.Add(positionalArgSpec.Name, positionalArg)
-
-				result, err := run(ctx, cobraCmd, cmd, rawArgsWithPositional)
-				if err != nil {
-					return err
-				}
-
-				results = append(results, result)
-			}
-		}
-		// If only one positional parameter was provided we return the result directly instead of
-		// an array of results
-		if len(results) == 1 {
-			meta.result = results[0]
-		} else {
-			meta.result = results
 		}
-
-		return nil
 	}
+	// If only one positional parameter was provided we return the result directly instead of
+	// an array of results
+	if len(results) == 1 {
+		return results[0], nil
+	}
+	return results, nil
+
 }
 
 func run(ctx context.Context, cobraCmd *cobra.Command, cmd *Command, rawArgs []string) (interface{}, error) {
@@ -120,7 +157,33 @@ func run(ctx context.Context, cobraCmd *cobra.Command, cmd *Command, rawArgs []s
 	// unmarshalled arguments will be store in this interface
 	cmdArgs := reflect.New(cmd.ArgsType).Interface()
 
-	sentry.AddArgumentsContext(args.SplitRaw(rawArgs))
+	filters, err := getFiltersFromFlags(cobraCmd, cmd)
+	if err != nil {
+		return nil, err
+	}
+	rawArgs = addServerSideFilters(cmd, rawArgs, filters)
+
+	sortKeys, err := getSortKeysFromFlags(cobraCmd, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// A list in every locality is run in each of them when the command cannot list them at once,
+	// its arguments are validated with the first locality
+	meta := extractMeta(ctx)
+	meta.localityColumn = ""
+	allLocalities := getAllLocalitiesArg(cmd, rawArgs)
+	localities := []string(nil)
+	if allLocalities != nil {
+		localities = fanOutLocalities(cmd, allLocalities)
+		if len(localities) > 0 {
+			rawArgs = args.RawArgs(rawArgs).Remove(allLocalities.Name).Add(allLocalities.Name, localities[0])
+		}
+	}
+
+	if meta.sendUsage && !cmd.DisableTelemetry {
+		sentry.AddArgumentsContext(args.SplitRaw(rawArgs))
+	}
 
 	// Unmarshal args.
 	// After that we are done working with rawArgs
@@ -162,26 +225,86 @@ func run(ctx context.Context, cobraCmd *cobra.Command, cmd *Command, rawArgs []s
 		return runWeb(cmd, cmdArgs)
 	}
 
+	page, err := getPageFromFlags(cobraCmd, cmd)
+	if err != nil {
+		return nil, err
+	}
+	// The page is fetched from the API unless the whole list is needed to filter, sort or merge it
+	fetchAPIPage := page != nil && len(filters) == 0 && len(sortKeys) == 0 && allLocalities == nil && setRequestPage(cmdArgs, page)
+
 	// execute the command
 	interceptor := CombineCommandInterceptor(
+		failedResponseInterceptor,
 		sdkStdErrorInterceptor,
 		sdkStdTypeInterceptor,
 		cmd.Interceptor,
 	)
 
-	data, err := interceptor(ctx, cmdArgs, func(ctx context.Context, argsI interface{}) (i interface{}, err error) {
-		return cmd.Run(ctx, argsI)
-	})
+	if meta.dryRun {
+		return runDryRun(ctx, cmd, func() (interface{}, error) {
+			return interceptor(ctx, cmdArgs, cmd.Run)
+		})
+	}
+
+	err = confirmDestructiveCommand(ctx, cmd, cmdArgs, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	var data interface{}
+	runner := func() (interface{}, error) {
+		if len(localities) > 1 {
+			return runInLocalities(ctx, cmdArgs, allLocalities, localities, func(ctx context.Context, argsI interface{}) (interface{}, error) {
+				return interceptor(ctx, argsI, cmd.Run)
+			})
+		}
+		return interceptor(ctx, cmdArgs, func(ctx context.Context, argsI interface{}) (i interface{}, err error) {
+			return cmd.Run(ctx, argsI)
+		})
+	}
+	switch {
+	case fetchAPIPage:
+		data, err = runWithAPIPage(ctx, page, runner)
+	case getAllPagesFromFlags(cobraCmd, cmd):
+		data, err = runWithParallelPages(ctx, runner)
+	default:
+		data, err = runner()
+	}
 	if err != nil {
 		return nil, err
 	}
 	waitFlag, err := cobraCmd.PersistentFlags().GetBool("wait")
 	if err == nil && cmd.WaitFunc != nil && waitFlag {
-		data, err = cmd.WaitFunc(ctx, cmdArgs, data)
+		spinner := interactive.StartSpinner("Waiting for " + cmd.Resource)
+		respI := data
+		data, err = runWaitWithProgress(ctx, cmd, func() (interface{}, error) {
+			return runWaitWithTimeout(ctx, cmd, func(ctx context.Context) (interface{}, error) {
+				return cmd.WaitFunc(ctx, cmdArgs, respI)
+			})
+		})
+		spinner.Stop()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if allLocalities != nil {
+		meta.localityColumn = localityColumn(allLocalities, data)
+	}
+	if len(filters) > 0 {
+		data, err = filterResult(data, filters)
 		if err != nil {
 			return nil, err
 		}
 	}
+	if len(sortKeys) > 0 {
+		data, err = sortResult(data, sortKeys)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if page != nil {
+		return paginateResult(data, page)
+	}
 	return data, nil
 }
 
@@ -246,9 +369,13 @@ Relative time error: %s
 			argNames = append(argNames, argSpec.Name)
 		}
 
+		hint := didYouMean(closestSuggestions(unmarshalErr.ArgName, argNames))
+		if hint == "" {
+			hint = fmt.Sprintf("Valid arguments are: %s", strings.Join(argNames, ", "))
+		}
 		return &CliError{
 			Err:  fmt.Errorf("unknown argument '%s'", unmarshalErr.ArgName),
-			Hint: fmt.Sprintf("Valid arguments are: %s", strings.Join(argNames, ", ")),
+			Hint: hint,
 		}
 
 	default:
@@ -257,7 +384,12 @@ Relative time error: %s
 }
 
 func cobraRunHelp(cmd *Command) func(cmd *cobra.Command, args []string) error {
-	return func(cobraCmd *cobra.Command, _ []string) error {
+	return func(cobraCmd *cobra.Command, args []string) error {
+		// Arguments of a command which is not runnable are a mistyped subcommand
+		if len(args) > 0 && cobraCmd.HasAvailableSubCommands() {
+			return unknownCommandError(cobraCmd, args[0])
+		}
+
 		webFlag, err := cobraCmd.PersistentFlags().GetBool("web")
 		if err == nil && webFlag {
 			out, err := runWeb(cmd, nil)
@@ -275,3 +407,15 @@ func cobraRunHelp(cmd *Command) func(cmd *cobra.Command, args []string) error {
 		return &CliError{Empty: true, Code: 1}
 	}
 }
+
+// unknownCommandError returns the error of an unknown subcommand of cobraCmd, suggesting the closest subcommands.
+func unknownCommandError(cobraCmd *cobra.Command, name string) error {
+	candidates := []string(nil)
+	for _, subCmd := range cobraCmd.Commands() {
+		if subCmd.IsAvailableCommand() {
+			candidates = append(candidates, subCmd.Name())
+			candidates = append(candidates, subCmd.Aliases...)
+		}
+	}
+	return UnknownCommandError(name, cobraCmd.CommandPath(), closestSuggestions(name, candidates))
+}
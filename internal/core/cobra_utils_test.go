@@ -27,6 +27,11 @@ type testAcceptMultiPositionalArgsType struct {
 	Tag     string
 }
 
+type testPassthroughResult struct {
+	NameID          string
+	PassthroughArgs []string
+}
+
 func testGetCommands() *core.Commands {
 	return core.NewCommands(
 		&core.Command{
@@ -79,6 +84,25 @@ func testGetCommands() *core.Commands {
 				return argsI, nil
 			},
 		},
+		&core.Command{
+			Namespace: "test",
+			Resource:  "passthrough",
+			ArgSpecs: core.ArgSpecs{
+				{
+					Name:       "name-id",
+					Positional: true,
+				},
+			},
+			AcceptPassthroughArgs: true,
+			AllowAnonymousClient:  true,
+			ArgsType:              reflect.TypeOf(testType{}),
+			Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+				return &testPassthroughResult{
+					NameID:          argsI.(*testType).NameID,
+					PassthroughArgs: core.ExtractPassthroughArgs(ctx),
+				}, nil
+			},
+		},
 		&core.Command{
 			Namespace:            "test",
 			Resource:             "raw-args",
@@ -134,6 +158,18 @@ func Test_handleUnmarshalErrors(t *testing.T) {
 		),
 	}))
 
+	t.Run("mistyped argument", core.Test(&core.TestConfig{
+		Commands: testGetCommands(),
+		Cmd:      "scw test nmae-id=plop",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckError(&core.CliError{
+				Err:  fmt.Errorf("unknown argument 'nmae-id'"),
+				Hint: "Did you mean 'name-id'?",
+			}),
+		),
+	}))
+
 	t.Run("relative date", core.Test(&core.TestConfig{
 		Commands: testGetCommands(),
 		Cmd:      "scw test date date=+3R",
@@ -318,3 +354,31 @@ func Test_MultiPositionalArg(t *testing.T) {
 		),
 	}))
 }
+
+func Test_PassthroughArgs(t *testing.T) {
+	t.Run("arguments after dash", core.Test(&core.TestConfig{
+		Commands: testGetCommands(),
+		Cmd:      "scw test passthrough pos1 -- -v name-id=pos2 --help",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				res := ctx.Result.(*testPassthroughResult)
+				assert.Equal(t, "pos1", res.NameID)
+				assert.Equal(t, []string{"-v", "name-id=pos2", "--help"}, res.PassthroughArgs)
+			},
+		),
+	}))
+
+	t.Run("no dash", core.Test(&core.TestConfig{
+		Commands: testGetCommands(),
+		Cmd:      "scw test passthrough pos1",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				res := ctx.Result.(*testPassthroughResult)
+				assert.Equal(t, "pos1", res.NameID)
+				assert.Empty(t, res.PassthroughArgs)
+			},
+		),
+	}))
+}
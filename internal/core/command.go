@@ -38,6 +38,9 @@ type Command struct {
 	// DisableAfterChecks disable checks that run after the command to avoid superfluous message
 	DisableAfterChecks bool
 
+	// DisableDryRun refuses --dry-run for a command changing local files or running programs before sending any API request.
+	DisableDryRun bool
+
 	// Hidden hides the command form usage and auto-complete.
 	Hidden bool
 
@@ -51,6 +54,14 @@ type Command struct {
 	// If enabled, positional argument is expected to be a list.
 	AcceptMultiplePositionalArgs bool
 
+	// AcceptNoPositionalArgs defines whether a command accepting multiple positional arguments can be run without any.
+	// It is used when the resources can also be selected by other arguments.
+	AcceptNoPositionalArgs bool
+
+	// AcceptPassthroughArgs defines whether the arguments given after `--` are passed to the command as is.
+	// They are not parsed as arguments of the command and are available with ExtractPassthroughArgs.
+	AcceptPassthroughArgs bool
+
 	// View defines the View for this command.
 	// It is used to create the different options for the different Marshalers.
 	View *View
@@ -76,6 +87,16 @@ type Command struct {
 	// Non-nil values returned by this method will be printed out.
 	Run CommandRunner
 
+	// Destructive asks the user to confirm before running the command in an interactive session.
+	// Commands with the delete verb are always destructive.
+	// The confirmation is skipped with the -y (--assume-yes) flag or the assume_yes config setting.
+	Destructive bool
+
+	// DangerousResourceNameFunc returns the name of the resource whose data is destroyed by a destructive command.
+	// When the profile sets confirm_dangerous to strict, the user must type this name instead of answering yes.
+	// An empty name means no data is destroyed with the given arguments.
+	DangerousResourceNameFunc func(ctx context.Context, argsI interface{}) (string, error)
+
 	// WaitFunc will be called if non-nil when the -w (--wait) flag is passed.
 	WaitFunc WaitFunc
 
@@ -86,6 +107,11 @@ type Command struct {
 	// WaitUsage override the usage for the -w (--wait) flag
 	WaitUsage string
 
+	// Paginated adds the --page, --page-size and --all flags to a command, list commands always have them.
+	// Run must return a slice. The requested page is fetched from the API when the request has a page size field,
+	// otherwise only the requested page of the returned slice is printed.
+	Paginated bool
+
 	// Aliases contains a list of aliases for a command
 	Aliases []string
 	// cache command path
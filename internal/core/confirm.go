@@ -0,0 +1,64 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cliConfig "github.com/scaleway/scaleway-cli/v2/internal/config"
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+)
+
+// commandIsDestructive returns whether cmd must be confirmed before it runs.
+// Every delete command is destructive, other commands opt in with the Destructive field.
+func commandIsDestructive(cmd *Command) bool {
+	return cmd.Destructive || (cmd.Run != nil && cmd.Verb == "delete")
+}
+
+// confirmDestructiveCommand asks the user to confirm a destructive command before it runs.
+// No confirmation is asked when the session is not interactive or when the user assumes yes.
+func confirmDestructiveCommand(ctx context.Context, cmd *Command, cmdArgs interface{}, rawArgs []string) error {
+	meta := extractMeta(ctx)
+	if !commandIsDestructive(cmd) || meta.assumeYes || !interactive.IsInteractive {
+		return nil
+	}
+
+	if cmd.DangerousResourceNameFunc != nil && meta.CliConfig.ProfileConfirmDangerous(ExtractProfileName(ctx)) == cliConfig.ConfirmDangerousStrict {
+		name, err := cmd.DangerousResourceNameFunc(ctx, cmdArgs)
+		if err != nil {
+			return err
+		}
+		if name != "" {
+			return confirmResourceName(ctx, name)
+		}
+	}
+
+	commandLine := strings.Join(append([]string{cmd.GetCommandLine(meta.BinaryName)}, rawArgs...), " ")
+	confirmed, err := interactive.PromptBoolWithConfig(&interactive.PromptBoolConfig{
+		Ctx:          ctx,
+		Prompt:       fmt.Sprintf("Are you sure you want to run '%s'?", commandLine),
+		DefaultValue: false,
+	})
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return CommandCanceledError()
+	}
+	return nil
+}
+
+// confirmResourceName asks the user to type the name of the resource whose data is about to be destroyed.
+func confirmResourceName(ctx context.Context, name string) error {
+	typedName, err := interactive.PromptStringWithConfig(&interactive.PromptStringConfig{
+		Ctx:    ctx,
+		Prompt: fmt.Sprintf("This operation cannot be undone, type '%s' to confirm", name),
+	})
+	if err != nil {
+		return err
+	}
+	if typedName != name {
+		return ResourceNameMismatchError(name)
+	}
+	return nil
+}
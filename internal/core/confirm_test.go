@@ -0,0 +1,90 @@
+package core_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+)
+
+func testDestructiveCommands() *core.Commands {
+	return core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "delete",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (i interface{}, e error) {
+				return &core.SuccessResult{Message: "item deleted"}, nil
+			},
+		},
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "purge",
+			AllowAnonymousClient: true,
+			Destructive:          true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (i interface{}, e error) {
+				return &core.SuccessResult{Message: "item purged"}, nil
+			},
+		},
+	)
+}
+
+func Test_ConfirmDestructiveCommand(t *testing.T) {
+	t.Run("Not interactive", core.Test(&core.TestConfig{
+		Commands: testDestructiveCommands(),
+		Cmd:      "scw test item delete",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("Assume yes", core.Test(&core.TestConfig{
+		Commands: testDestructiveCommands(),
+		Cmd:      "scw test item delete -y",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("Interactive", func(t *testing.T) {
+		interactive.IsInteractive = true
+		t.Cleanup(func() {
+			interactive.IsInteractive = false
+		})
+
+		t.Run("Delete canceled", core.Test(&core.TestConfig{
+			Commands:            testDestructiveCommands(),
+			Cmd:                 "scw test item delete",
+			PromptResponseMocks: []string{"no"},
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(1),
+				func(t *testing.T, ctx *core.CheckFuncCtx) {
+					assert.Nil(t, ctx.Result)
+				},
+			),
+			DisableParallel: true,
+		}))
+
+		t.Run("Destructive confirmed", core.Test(&core.TestConfig{
+			Commands:            testDestructiveCommands(),
+			Cmd:                 "scw test item purge",
+			PromptResponseMocks: []string{"yes"},
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(0),
+				func(t *testing.T, ctx *core.CheckFuncCtx) {
+					assert.Equal(t, &core.SuccessResult{Message: "item purged"}, ctx.Result)
+				},
+			),
+			DisableParallel: true,
+		}))
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"time"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/alias"
 	cliConfig "github.com/scaleway/scaleway-cli/v2/internal/config"
@@ -33,8 +34,17 @@ type Meta struct {
 	stdout                      io.Writer
 	stderr                      io.Writer
 	stdin                       io.Reader
+	printer                     *Printer
 	result                      interface{}
+	passthroughArgs             []string
 	httpClient                  *http.Client
+	progressBus                 *progressBus
+	failedResponses             *failedResponseRecorder
+	assumeYes                   bool
+	dryRun                      bool
+	sendUsage                   bool
+	timeout                     time.Duration
+	localityColumn              string
 	isClientFromBootstrapConfig bool
 	BetaMode                    bool
 }
@@ -104,6 +114,10 @@ func ExtractLogger(ctx context.Context) *Logger {
 	return extractMeta(ctx).Logger
 }
 
+func ExtractPlatform(ctx context.Context) platform.Platform {
+	return extractMeta(ctx).Platform
+}
+
 func ExtractBuildInfo(ctx context.Context) *BuildInfo {
 	return extractMeta(ctx).BuildInfo
 }
@@ -138,6 +152,11 @@ func ExtractCacheDir(ctx context.Context) string {
 	return scw.GetCacheDirectory()
 }
 
+// ExtractPassthroughArgs returns the arguments given after `--` to a command accepting passthrough arguments.
+func ExtractPassthroughArgs(ctx context.Context) []string {
+	return extractMeta(ctx).passthroughArgs
+}
+
 func ExtractBinaryName(ctx context.Context) string {
 	return extractMeta(ctx).BinaryName
 }
@@ -146,6 +165,16 @@ func ExtractStdin(ctx context.Context) io.Reader {
 	return extractMeta(ctx).stdin
 }
 
+// ExtractStdout returns the output of the command, for commands streaming their result as it comes such as logs.
+func ExtractStdout(ctx context.Context) io.Writer {
+	return extractMeta(ctx).stdout
+}
+
+// InjectProfileName selects the profile used by the current command, as if it was given with the --profile flag.
+func InjectProfileName(ctx context.Context, profileName string) {
+	extractMeta(ctx).ProfileFlag = profileName
+}
+
 func ExtractProfileName(ctx context.Context) string {
 	// Handle profile flag -p
 	if extractMeta(ctx).ProfileFlag != "" {
@@ -197,8 +226,14 @@ func ExtractCliConfigPath(ctx context.Context) string {
 func ReloadClient(ctx context.Context) error {
 	var err error
 	meta := extractMeta(ctx)
-	meta.Client, err = meta.Platform.CreateClient(meta.httpClient, ExtractConfigPath(ctx), ExtractProfileName(ctx))
-	return err
+	meta.Client, err = meta.Platform.CreateClient(ctx, meta.httpClient, ExtractConfigPath(ctx), ExtractProfileName(ctx), func(key string) string {
+		return ExtractEnv(ctx, key)
+	})
+	if err != nil {
+		return err
+	}
+	registerClientSecret(meta.Client)
+	return nil
 }
 
 func ExtractConfigPathFlag(ctx context.Context) string {
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/redact"
+)
+
+// debugTracePath returns the file keeping the debug logs of the last command run with --debug
+func debugTracePath(ctx context.Context) string {
+	return filepath.Join(ExtractCacheDir(ctx), "last-debug.log")
+}
+
+// ReadLastDebugTrace returns the debug logs of the last command run with --debug, or an empty string if there is none.
+// Secrets are masked in the logs as they are when printed.
+func ReadLastDebugTrace(ctx context.Context) (string, error) {
+	content, err := os.ReadFile(debugTracePath(ctx))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	return string(content), err
+}
+
+// recordDebugTrace copies the logs of the command into a buffer, they are saved when the returned function is called.
+// They are saved at the end of the command so that a command can read the trace of the previous one.
+func recordDebugTrace(ctx context.Context, log *Logger, args []string) func() {
+	trace := &bytes.Buffer{}
+	_, _ = fmt.Fprint(trace, redact.String(fmt.Sprintf("running: %s\n", args)))
+
+	writer := log.writer
+	log.writer = io.MultiWriter(writer, trace)
+
+	return func() {
+		log.writer = writer
+
+		path := debugTracePath(ctx)
+		err := os.MkdirAll(filepath.Dir(path), 0700)
+		if err == nil {
+			err = os.WriteFile(path, trace.Bytes(), 0600)
+		}
+		if err != nil {
+			log.Debugf("cannot save debug trace: %s\n", err)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package core_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func Test_DebugTrace(t *testing.T) {
+	t.Run("Saved with debug", core.Test(&core.TestConfig{
+		Commands:   core.NewCommands(fakeCommand),
+		Cmd:        "scw plop -D",
+		TmpHomeDir: true,
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				trace, err := os.ReadFile(filepath.Join(ctx.OverrideEnv[scw.ScwCacheDirEnv], "last-debug.log"))
+				assert.NoError(t, err)
+				assert.Contains(t, string(trace), "running: [scw plop -D]\n")
+				assert.Contains(t, ctx.LogBuffer, string(trace))
+			},
+		),
+	}))
+
+	t.Run("Not saved without debug", core.Test(&core.TestConfig{
+		Commands:   core.NewCommands(fakeCommand),
+		Cmd:        "scw plop",
+		TmpHomeDir: true,
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				_, err := os.Stat(filepath.Join(ctx.OverrideEnv[scw.ScwCacheDirEnv], "last-debug.log"))
+				assert.True(t, os.IsNotExist(err))
+			},
+		),
+	}))
+}
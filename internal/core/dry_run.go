@@ -0,0 +1,120 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/redact"
+)
+
+// errDryRun stops a command once its first API request has been captured.
+var errDryRun = errors.New("request not sent, dry run")
+
+// DryRunRequest is the API request a command would have sent, printed by --dry-run.
+type DryRunRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+func (r *DryRunRequest) MarshalHuman() (string, error) {
+	buf := &strings.Builder{}
+	_, _ = fmt.Fprintf(buf, "%s %s\n", r.Method, r.URL)
+
+	keys := make([]string, 0, len(r.Headers))
+	for key := range r.Headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(buf, "%s: %s\n", key, r.Headers[key])
+	}
+
+	if len(r.Body) > 0 {
+		body := &bytes.Buffer{}
+		if err := json.Indent(body, r.Body, "", "  "); err != nil {
+			return "", err
+		}
+		_, _ = fmt.Fprintf(buf, "\n%s\n", body.String())
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// dryRunTransport captures the first request of a command instead of sending it.
+// No request reaches the API, the command stops at its first request.
+type dryRunTransport struct {
+	request *DryRunRequest
+}
+
+func (t *dryRunTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	dryRunRequest := &DryRunRequest{
+		Method:  request.Method,
+		URL:     request.URL.String(),
+		Headers: map[string]string{},
+	}
+	for key := range request.Header {
+		dryRunRequest.Headers[key] = redact.Header(key, request.Header.Get(key))
+	}
+
+	if request.Body != nil {
+		body, err := io.ReadAll(request.Body)
+		_ = request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		// Only JSON bodies are printed, raw uploads would flood the terminal
+		if json.Valid(body) {
+			dryRunRequest.Body = body
+		}
+	}
+
+	if t.request == nil {
+		t.request = dryRunRequest
+	}
+	return nil, errDryRun
+}
+
+// commandHasDryRun reports whether a command can be run with --dry-run.
+// Commands running without credentials do not use the API, as the commands with DisableDryRun,
+// they change local files or run programs that cannot be printed as an API request.
+func commandHasDryRun(cmd *Command) bool {
+	return !cmd.AllowAnonymousClient && !cmd.DisableDryRun
+}
+
+// runDryRun runs a command with a transport capturing its first API request and returns that request.
+func runDryRun(ctx context.Context, cmd *Command, runner func() (interface{}, error)) (interface{}, error) {
+	meta := extractMeta(ctx)
+	if !commandHasDryRun(cmd) {
+		return nil, &CliError{
+			Err:  fmt.Errorf("--dry-run cannot be used with %s", cmd.GetCommandLine(meta.BinaryName)),
+			Hint: "this command does not only send API requests, it would run as usual",
+		}
+	}
+	if meta.httpClient == nil {
+		return nil, &CliError{
+			Err: errors.New("--dry-run cannot be used without the HTTP client of the CLI"),
+		}
+	}
+
+	previousTransport := meta.httpClient.Transport
+	transport := &dryRunTransport{}
+	meta.httpClient.Transport = transport
+	defer func() {
+		meta.httpClient.Transport = previousTransport
+	}()
+
+	result, err := runner()
+	if transport.request != nil {
+		return transport.request, nil
+	}
+	return result, err
+}
@@ -0,0 +1,116 @@
+package core_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+func testDryRunCommands() *core.Commands {
+	return core.NewCommands(
+		&core.Command{
+			Namespace: "test",
+			Resource:  "item",
+			Verb:      "create",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+				request, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.scaleway.com/test/v1/items", bytes.NewBufferString(`{"name":"a"}`))
+				if err != nil {
+					return nil, err
+				}
+				request.Header.Set("Content-Type", "application/json")
+				request.Header.Set("X-Auth-Token", "11111111-1111-1111-1111-111111111111")
+
+				res, err := core.ExtractHTTPClient(ctx).Do(request)
+				if err != nil {
+					return nil, err
+				}
+				_ = res.Body.Close()
+				return &core.SuccessResult{Message: "item created"}, nil
+			},
+		},
+	)
+}
+
+func Test_DryRun(t *testing.T) {
+	var sentMethods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sentMethods = append(sentMethods, r.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("Print request", core.Test(&core.TestConfig{
+		Commands: testDryRunCommands(),
+		Cmd:      "scw test item create --dry-run",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("No request sent", core.Test(&core.TestConfig{
+		Commands: core.NewCommands(&core.Command{
+			Namespace: "test",
+			Resource:  "item",
+			Verb:      "delete",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+				// Fetches the item before deleting it like most delete commands
+				for _, method := range []string{http.MethodGet, http.MethodDelete} {
+					request, err := http.NewRequestWithContext(ctx, method, server.URL+"/items/1", nil)
+					if err != nil {
+						return nil, err
+					}
+					res, err := core.ExtractHTTPClient(ctx).Do(request)
+					if err != nil {
+						return nil, err
+					}
+					_ = res.Body.Close()
+				}
+				return &core.SuccessResult{Message: "item deleted"}, nil
+			},
+		}),
+		Cmd: "scw test item delete --dry-run",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				t.Helper()
+				assert.Equal(t, http.MethodGet, ctx.Result.(*core.DryRunRequest).Method)
+				assert.Equal(t, 0, len(sentMethods))
+			},
+		),
+	}))
+
+	t.Run("Command without API requests", func(t *testing.T) {
+		ran := false
+		core.Test(&core.TestConfig{
+			Commands: core.NewCommands(&core.Command{
+				Namespace:            "test",
+				Resource:             "config",
+				Verb:                 "set",
+				AllowAnonymousClient: true,
+				ArgsType:             reflect.TypeOf(struct{}{}),
+				Run: func(_ context.Context, _ interface{}) (i interface{}, e error) {
+					ran = true
+					return &core.SuccessResult{}, nil
+				},
+			}),
+			Cmd: "scw test config set --dry-run",
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(1),
+				core.TestCheckGolden(),
+				func(t *testing.T, _ *core.CheckFuncCtx) {
+					t.Helper()
+					assert.False(t, ran)
+				},
+			),
+		})(t)
+	})
+}
@@ -8,6 +8,23 @@ import (
 	"github.com/scaleway/scaleway-cli/v2/internal/human"
 )
 
+// Exit codes of the CLI.
+// A command running another program, like ssh, may also exit with the code of that program.
+const (
+	// ExitCodeError is returned when a command fails.
+	ExitCodeError = 1
+	// ExitCodeInterrupted is returned when the user interrupts the CLI with Ctrl+C.
+	ExitCodeInterrupted = 130
+)
+
+// ExitCode returns the exit code of the CLI for the error a command returned.
+func ExitCode(err error) int {
+	if cliErr, ok := err.(*CliError); ok && cliErr.Code != 0 {
+		return cliErr.Code
+	}
+	return ExitCodeError
+}
+
 // CliError is an all-in-one error structure that can be used in commands to return useful errors to the user.
 // CliError implements JSON and human marshaler for a smooth experience.
 type CliError struct {
@@ -29,6 +46,11 @@ type CliError struct {
 
 	// Empty tells the marshaler to not print any message for the error
 	Empty bool
+
+	// RequestID and StatusCode describe the failed API response that triggers this CLI error.
+	// The request ID lets the support find the request in the API logs.
+	RequestID  string
+	StatusCode int
 }
 
 func (s *CliError) Error() string {
@@ -68,6 +90,14 @@ func (s *CliError) MarshalHuman() (string, error) {
 		sections = append(sections, str)
 	}
 
+	if s.RequestID != "" {
+		str, err := human.Marshal(fmt.Sprintf("%s (HTTP %d)", s.RequestID, s.StatusCode), &human.MarshalOpt{Title: "Request ID"})
+		if err != nil {
+			return "", err
+		}
+		sections = append(sections, str)
+	}
+
 	return strings.Join(sections, "\n\n"), nil
 }
 
@@ -83,15 +113,21 @@ func (s *CliError) MarshalJSON() ([]byte, error) {
 	}
 
 	type tmpRes struct {
-		Message string `json:"message,omitempty"`
-		Error   error  `json:"error,omitempty"`
-		Details string `json:"details,omitempty"`
-		Hint    string `json:"hint,omitempty"`
+		Message    string `json:"message,omitempty"`
+		Error      error  `json:"error,omitempty"`
+		Details    string `json:"details,omitempty"`
+		Hint       string `json:"hint,omitempty"`
+		RequestID  string `json:"request_id,omitempty"`
+		StatusCode int    `json:"status_code,omitempty"`
+		Code       int    `json:"code"`
 	}
 	return json.Marshal(&tmpRes{
-		Message: message,
-		Error:   s.Err,
-		Details: s.Details,
-		Hint:    s.Hint,
+		Message:    message,
+		Error:      s.Err,
+		Details:    s.Details,
+		Hint:       s.Hint,
+		RequestID:  s.RequestID,
+		StatusCode: s.StatusCode,
+		Code:       ExitCode(s),
 	})
 }
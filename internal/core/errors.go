@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"time"
 )
 
 func MissingRequiredArgumentError(argumentName string) *CliError {
@@ -10,6 +11,17 @@ func MissingRequiredArgumentError(argumentName string) *CliError {
 	}
 }
 
+func UnknownCommandError(command string, parentCommand string, suggestions []string) *CliError {
+	hint := didYouMean(suggestions)
+	if hint == "" {
+		hint = fmt.Sprintf("Use '%s -h' to list the available commands.", parentCommand)
+	}
+	return &CliError{
+		Err:  fmt.Errorf("unknown command '%s' for '%s'", command, parentCommand),
+		Hint: hint,
+	}
+}
+
 func InvalidValueForEnumError(argSpecName string, argSpecEnumValues []string, value string) *CliError {
 	return &CliError{
 		Err:  fmt.Errorf("invalid value '%v' for arg '%v'", value, argSpecName),
@@ -76,3 +88,24 @@ func WindowIsNotSupportedError() *CliError {
 		Err: fmt.Errorf("windows is not currently supported"),
 	}
 }
+
+func CommandCanceledError() *CliError {
+	return &CliError{
+		Err:  fmt.Errorf("command canceled"),
+		Hint: "Use -y (--assume-yes) to run destructive commands without confirmation.",
+	}
+}
+
+func ResourceNameMismatchError(name string) *CliError {
+	return &CliError{
+		Err:  fmt.Errorf("command canceled, the typed name does not match %s", name),
+		Hint: "Type the exact name of the resource to confirm, or use -y (--assume-yes) to skip the confirmation.",
+	}
+}
+
+func WaitTimeoutError(resource string, timeout time.Duration) *CliError {
+	return &CliError{
+		Err:  fmt.Errorf("timeout of %s reached while waiting for %s", timeout, resource),
+		Hint: "Use a longer --timeout, the command itself may have succeeded.",
+	}
+}
@@ -0,0 +1,264 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/args"
+	"github.com/spf13/cobra"
+)
+
+// listFilter keeps the items of a list whose field matches a value, requested with --filter.
+type listFilter struct {
+	// Field is the path of the field in the JSON output of an item, e.g. public_ip.address.
+	Field string
+	Value string
+	// Glob is set when Value contains wildcards.
+	Glob bool
+	// Regexp is set for a key~=value filter.
+	Regexp *regexp.Regexp
+}
+
+// isExact reports whether the filter only keeps the items whose field is equal to its value.
+func (f *listFilter) isExact() bool {
+	return !f.Glob && f.Regexp == nil
+}
+
+func (f *listFilter) match(value string) bool {
+	switch {
+	case f.Regexp != nil:
+		return f.Regexp.MatchString(value)
+	case f.Glob:
+		matched, _ := path.Match(f.Value, value)
+		return matched
+	default:
+		return value == f.Value
+	}
+}
+
+// commandIsList reports whether a command lists resources, its result being a slice.
+func commandIsList(cmd *Command) bool {
+	return cmd.Run != nil && (cmd.Verb == "list" || cmd.Paginated)
+}
+
+func addFilterFlag(cobraCmd *cobra.Command) {
+	cobraCmd.PersistentFlags().StringArray("filter", nil, "only show the items whose field matches, e.g. name=web-* or name~=^web-[0-9]+$ for a regular expression, can be repeated")
+}
+
+// getFiltersFromFlags returns the filters requested with --filter.
+func getFiltersFromFlags(cobraCmd *cobra.Command, cmd *Command) ([]*listFilter, error) {
+	if !commandIsList(cmd) {
+		return nil, nil
+	}
+
+	rawFilters, err := cobraCmd.PersistentFlags().GetStringArray("filter")
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]*listFilter, 0, len(rawFilters))
+	for _, rawFilter := range rawFilters {
+		filter, err := parseFilter(rawFilter)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func parseFilter(rawFilter string) (*listFilter, error) {
+	field, value, found := strings.Cut(rawFilter, "=")
+	field = strings.ReplaceAll(field, "-", "_")
+	if !found || field == "" || field == "~" {
+		return nil, &CliError{
+			Err:  fmt.Errorf("invalid filter %s", rawFilter),
+			Hint: "filters must be in the form field=value, e.g. --filter name=web-* --filter state=running",
+		}
+	}
+
+	filter := &listFilter{
+		Field: field,
+		Value: value,
+	}
+	if strings.HasSuffix(field, "~") {
+		filter.Field = strings.TrimSuffix(field, "~")
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return nil, &CliError{
+				Err:  fmt.Errorf("invalid regular expression in filter %s: %w", rawFilter, err),
+				Hint: "see https://github.com/google/re2/wiki/Syntax for the syntax of regular expressions",
+			}
+		}
+		filter.Regexp = re
+		return filter, nil
+	}
+
+	if strings.ContainsAny(value, "*?[") {
+		if _, err := path.Match(value, ""); err != nil {
+			return nil, &CliError{
+				Err:  fmt.Errorf("invalid pattern in filter %s: %w", rawFilter, err),
+				Hint: "patterns can use * to match any characters, ? to match a single character and [a-z] to match a range",
+			}
+		}
+		filter.Glob = true
+	}
+	return filter, nil
+}
+
+// addServerSideFilters sends the exact filters matching an argument of the command to the API,
+// so the list is filtered by the API when it supports it. Filters are still applied on the result,
+// as the API may be less strict, e.g. a name filter often matches a part of the name.
+func addServerSideFilters(cmd *Command, rawArgs args.RawArgs, filters []*listFilter) args.RawArgs {
+	for _, filter := range filters {
+		if !filter.isExact() {
+			continue
+		}
+		argName := strings.ReplaceAll(filter.Field, "_", "-")
+		argSpec := cmd.ArgSpecs.GetByName(argName)
+		if argSpec == nil || argSpec.Positional || argSpec.Deprecated {
+			continue
+		}
+		if _, exists := rawArgs.Get(argName); exists {
+			continue
+		}
+		rawArgs = rawArgs.Add(argName, filter.Value)
+	}
+	return rawArgs
+}
+
+// filterResult keeps the items of a list result matching all the filters.
+func filterResult(result interface{}, filters []*listFilter) (interface{}, error) {
+	if pagedResult, isPaged := result.(*PagedResult); isPaged {
+		items, err := filterResult(pagedResult.Items, filters)
+		if err != nil {
+			return nil, err
+		}
+		pagedResult.Items = items
+		return pagedResult, nil
+	}
+
+	value := reflect.ValueOf(result)
+	if value.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("cannot filter a result of type %T, a slice is expected", result)
+	}
+
+	filtered := reflect.MakeSlice(value.Type(), 0, value.Len())
+	knownFields := map[string]bool{}
+	var itemFields []string
+	for i := 0; i < value.Len(); i++ {
+		item, err := jsonItem(value.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		if itemFields == nil {
+			itemFields = jsonFields(item)
+		}
+
+		matchAll := true
+		for _, filter := range filters {
+			fieldValues, found := jsonFieldValues(item, strings.Split(filter.Field, "."))
+			if found {
+				knownFields[filter.Field] = true
+			}
+			if !matchAnyValue(filter, fieldValues) {
+				matchAll = false
+			}
+		}
+		if matchAll {
+			filtered = reflect.Append(filtered, value.Index(i))
+		}
+	}
+
+	if value.Len() > 0 {
+		for _, filter := range filters {
+			if !knownFields[filter.Field] {
+				return nil, &CliError{
+					Err:  fmt.Errorf("unknown field %s in filter", filter.Field),
+					Hint: "available fields: " + strings.Join(itemFields, ", "),
+				}
+			}
+		}
+	}
+
+	return filtered.Interface(), nil
+}
+
+func matchAnyValue(filter *listFilter, values []string) bool {
+	for _, value := range values {
+		if filter.match(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonItem returns an item as it is printed in JSON, so filters use the field names users see.
+func jsonItem(item interface{}) (interface{}, error) {
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	var jsonValue interface{}
+	err = json.Unmarshal(raw, &jsonValue)
+	return jsonValue, err
+}
+
+func jsonFields(item interface{}) []string {
+	object, isObject := item.(map[string]interface{})
+	if !isObject {
+		return []string{}
+	}
+	fields := make([]string, 0, len(object))
+	for field := range object {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// jsonFieldValues returns the values at a path in a JSON value as strings.
+// Arrays are walked through, so tags=prod matches the items having a prod tag.
+func jsonFieldValues(value interface{}, fieldPath []string) ([]string, bool) {
+	if array, isArray := value.([]interface{}); isArray {
+		values := []string(nil)
+		found := false
+		for _, element := range array {
+			elementValues, elementFound := jsonFieldValues(element, fieldPath)
+			values = append(values, elementValues...)
+			found = found || elementFound
+		}
+		return values, found || len(fieldPath) == 0
+	}
+
+	if len(fieldPath) == 0 {
+		switch v := value.(type) {
+		case nil:
+			return []string{""}, true
+		case string:
+			return []string{v}, true
+		case float64:
+			return []string{strconv.FormatFloat(v, 'f', -1, 64)}, true
+		case bool:
+			return []string{strconv.FormatBool(v)}, true
+		default:
+			return nil, true
+		}
+	}
+
+	object, isObject := value.(map[string]interface{})
+	if !isObject {
+		return nil, false
+	}
+	fieldValue, exists := object[fieldPath[0]]
+	if !exists {
+		return nil, false
+	}
+	return jsonFieldValues(fieldValue, fieldPath[1:])
+}
@@ -0,0 +1,116 @@
+package core_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+type testFilterServer struct {
+	Name  string   `json:"name"`
+	State string   `json:"state"`
+	Tags  []string `json:"tags"`
+}
+
+type testFilterListArgs struct {
+	State string
+}
+
+func testFilterCommands() *core.Commands {
+	servers := []*testFilterServer{
+		{Name: "web-1", State: "running", Tags: []string{"prod", "front"}},
+		{Name: "web-2", State: "stopped", Tags: []string{"front"}},
+		{Name: "db-1", State: "running", Tags: []string{"prod"}},
+	}
+
+	return core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "server",
+			Verb:                 "list",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(testFilterListArgs{}),
+			ArgSpecs: core.ArgSpecs{
+				{
+					Name: "state",
+				},
+			},
+			Run: func(_ context.Context, argsI interface{}) (i interface{}, e error) {
+				// Mocks an API filtering servers by state
+				args := argsI.(*testFilterListArgs)
+				result := []*testFilterServer(nil)
+				for _, server := range servers {
+					if args.State == "" || server.State == args.State {
+						result = append(result, server)
+					}
+				}
+				return result, nil
+			},
+		},
+	)
+}
+
+func filteredServerNames(t *testing.T, ctx *core.CheckFuncCtx) []string {
+	t.Helper()
+	names := []string(nil)
+	for _, server := range ctx.Result.([]*testFilterServer) {
+		names = append(names, server.Name)
+	}
+	return names
+}
+
+func Test_Filter(t *testing.T) {
+	t.Run("Glob", core.Test(&core.TestConfig{
+		Commands: testFilterCommands(),
+		Cmd:      "scw test server list --filter name=web-*",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, []string{"web-1", "web-2"}, filteredServerNames(t, ctx))
+			},
+		),
+	}))
+
+	t.Run("Regular expression", core.Test(&core.TestConfig{
+		Commands: testFilterCommands(),
+		Cmd:      "scw test server list --filter name~=-1$",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, []string{"web-1", "db-1"}, filteredServerNames(t, ctx))
+			},
+		),
+	}))
+
+	t.Run("Several filters on a server side field and an array", core.Test(&core.TestConfig{
+		Commands: testFilterCommands(),
+		Cmd:      "scw test server list --filter state=running --filter tags=front",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, []string{"web-1"}, filteredServerNames(t, ctx))
+			},
+		),
+	}))
+
+	t.Run("Unknown field", core.Test(&core.TestConfig{
+		Commands: testFilterCommands(),
+		Cmd:      "scw test server list --filter status=running",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("Invalid filter", core.Test(&core.TestConfig{
+		Commands: testFilterCommands(),
+		Cmd:      "scw test server list --filter running",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+	}))
+}
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/redact"
+)
+
+// debugCurlEnv enables the dump of a curl command equivalent to each request in debug mode.
+const debugCurlEnv = "SCW_DEBUG_CURL"
+
+// debugHTTPTransport logs every request and response going through the CLI HTTP client.
+// It is only installed in debug mode.
+type debugHTTPTransport struct {
+	transport http.RoundTripper
+	logger    *Logger
+	curl      bool
+}
+
+func (d *debugHTTPTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	d.logger.Debugf("--> %s %s\n%s", request.Method, request.URL.String(), formatHeaders(request.Header))
+	if len(requestBody) > 0 {
+		d.logger.Debugf("%s\n", requestBody)
+	}
+	if d.curl {
+		d.logger.Debugf("%s\n", curlCommand(request, requestBody))
+	}
+
+	start := time.Now()
+	res, err := d.transport.RoundTrip(request)
+	duration := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		d.logger.Debugf("<-- %s %s failed after %s: %s\n", request.Method, request.URL.String(), duration, err)
+		return nil, err
+	}
+
+	responseBody, err := readResponseBody(res)
+	if err != nil {
+		return nil, err
+	}
+	d.logger.Debugf("<-- %s %s (%s)\n", res.Status, request.URL.String(), duration)
+	if len(responseBody) > 0 {
+		d.logger.Debugf("%s\n", responseBody)
+	}
+
+	return res, nil
+}
+
+// readRequestBody reads the body of a request and replaces it so it can still be sent.
+func readRequestBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(request.Body)
+	_ = request.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// readResponseBody reads the body of a response and replaces it so it can still be decoded.
+func readResponseBody(res *http.Response) ([]byte, error) {
+	if res.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// formatHeaders prints one header per line, sorted by name, with secrets redacted.
+func formatHeaders(header http.Header) string {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	buf := &strings.Builder{}
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(buf, "%s: %s\n", key, redact.Header(key, header.Get(key)))
+	}
+	return buf.String()
+}
+
+// curlCommand returns a curl command sending the same request.
+// The secret key is read from the SCW_SECRET_KEY environment variable so the command can be shared.
+func curlCommand(request *http.Request, body []byte) string {
+	keys := make([]string, 0, len(request.Header))
+	for key := range request.Header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := []string{"curl", "-X", request.Method, shellQuote(request.URL.String())}
+	for _, key := range keys {
+		if redact.IsSecretHeader(key) {
+			parts = append(parts, "-H", `"`+key+`: $SCW_SECRET_KEY"`)
+			continue
+		}
+		parts = append(parts, "-H", shellQuote(key+": "+request.Header.Get(key)))
+	}
+	if len(body) > 0 {
+		parts = append(parts, "--data", shellQuote(string(body)))
+	}
+	return strings.Join(parts, " ")
+}
+
+// shellQuote quotes a string for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
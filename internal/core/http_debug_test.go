@@ -0,0 +1,62 @@
+package core_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+func Test_DebugHTTPTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"item-id"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	commands := core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "create",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+				request, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/items", bytes.NewBufferString(`{"name":"a"}`))
+				if err != nil {
+					return nil, err
+				}
+				request.Header.Set("X-Auth-Token", "11111111-1111-1111-1111-111111111111")
+
+				res, err := core.ExtractHTTPClient(ctx).Do(request)
+				if err != nil {
+					return nil, err
+				}
+				_ = res.Body.Close()
+				return &core.SuccessResult{Message: "item created"}, nil
+			},
+		},
+	)
+
+	t.Run("Log requests", core.Test(&core.TestConfig{
+		Commands:    commands,
+		Cmd:         "scw test item create -D",
+		OverrideEnv: map[string]string{"SCW_DEBUG_CURL": "true"},
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, ctx.LogBuffer, "--> POST "+server.URL+"/items")
+				assert.Contains(t, ctx.LogBuffer, `{"name":"a"}`)
+				assert.Contains(t, ctx.LogBuffer, "<-- 200 OK "+server.URL+"/items")
+				assert.Contains(t, ctx.LogBuffer, `{"id":"item-id"}`)
+				assert.Contains(t, ctx.LogBuffer, `curl -X POST '`+server.URL+`/items' -H "X-Auth-Token: $SCW_SECRET_KEY"`)
+				assert.NotContains(t, ctx.LogBuffer, "11111111-1111-1111-1111-111111111111")
+			},
+		),
+	}))
+}
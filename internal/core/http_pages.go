@@ -0,0 +1,320 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// parallelPageRequests is the number of pages fetched at the same time by --all.
+const parallelPageRequests = 5
+
+// prefetchedPage is a page fetched ahead of the request of the SDK.
+type prefetchedPage struct {
+	done       chan struct{}
+	statusCode int
+	header     http.Header
+	body       []byte
+	err        error
+}
+
+// parallelPagesTransport speeds up the listing of every page of a list.
+// The SDK fetches pages one after the other, so once the first page gives the total count,
+// the other pages are fetched concurrently and the following requests of the SDK are served from them.
+type parallelPagesTransport struct {
+	transport http.RoundTripper
+
+	mu    sync.Mutex
+	pages map[string]*prefetchedPage
+}
+
+func newParallelPagesTransport(transport http.RoundTripper) *parallelPagesTransport {
+	return &parallelPagesTransport{
+		transport: transport,
+		pages:     map[string]*prefetchedPage{},
+	}
+}
+
+func (p *parallelPagesTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Method != http.MethodGet {
+		return p.transport.RoundTrip(request)
+	}
+
+	if page := p.popPage(request.URL.String()); page != nil {
+		<-page.done
+		// A failed prefetch is sent again so the error is the one of a regular request
+		if page.err == nil {
+			return &http.Response{
+				Status:        strconv.Itoa(page.statusCode) + " " + http.StatusText(page.statusCode),
+				StatusCode:    page.statusCode,
+				Proto:         "HTTP/1.1",
+				ProtoMajor:    1,
+				ProtoMinor:    1,
+				Header:        page.header.Clone(),
+				Body:          io.NopCloser(bytes.NewReader(page.body)),
+				ContentLength: int64(len(page.body)),
+				Request:       request,
+			}, nil
+		}
+	}
+
+	// The SDK always sets page=1 on the first request of a list fetched with all its pages
+	res, err := p.transport.RoundTrip(request)
+	if err != nil || res.StatusCode != http.StatusOK || request.URL.Query().Get("page") != "1" {
+		return res, err
+	}
+
+	body, err := readResponseBody(res)
+	if err != nil {
+		return nil, err
+	}
+	if pageCount := listPageCount(request.URL.Query(), res.Header, body); pageCount > 1 {
+		p.prefetchPages(request, pageCount)
+	}
+	return res, nil
+}
+
+func (p *parallelPagesTransport) popPage(url string) *prefetchedPage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	page := p.pages[url]
+	delete(p.pages, url)
+	return page
+}
+
+// prefetchPages fetches the pages 2 to pageCount of the list of the first page request.
+func (p *parallelPagesTransport) prefetchPages(firstPage *http.Request, pageCount int) {
+	requests := make(chan *http.Request, pageCount-1)
+	pages := make(map[*http.Request]*prefetchedPage, pageCount-1)
+	p.mu.Lock()
+	for number := 2; number <= pageCount; number++ {
+		request := firstPage.Clone(firstPage.Context())
+		query := request.URL.Query()
+		query.Set("page", strconv.Itoa(number))
+		request.URL.RawQuery = query.Encode()
+
+		page := &prefetchedPage{done: make(chan struct{})}
+		p.pages[request.URL.String()] = page
+		pages[request] = page
+		requests <- request
+	}
+	p.mu.Unlock()
+	close(requests)
+
+	for i := 0; i < min(parallelPageRequests, pageCount-1); i++ {
+		go func() {
+			for request := range requests {
+				fetchPage(p.transport, request, pages[request])
+			}
+		}()
+	}
+}
+
+func fetchPage(transport http.RoundTripper, request *http.Request, page *prefetchedPage) {
+	defer close(page.done)
+
+	res, err := transport.RoundTrip(request)
+	if err != nil {
+		page.err = err
+		return
+	}
+	page.body, page.err = readResponseBody(res)
+	page.statusCode = res.StatusCode
+	page.header = res.Header
+}
+
+// listPageCount returns the number of pages of a list from its first page,
+// or 0 if the response is not a list with a total count.
+func listPageCount(query url.Values, header http.Header, body []byte) int {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return 0
+	}
+	itemCount, isList := listItemCount(fields)
+	if !isList || itemCount == 0 {
+		return 0
+	}
+
+	// The instance API gives the total count in a header
+	totalCount := 0
+	if xTotalCount := header.Get("X-Total-Count"); xTotalCount != "" {
+		count, err := strconv.Atoi(xTotalCount)
+		if err != nil {
+			return 0
+		}
+		totalCount = count
+	} else if err := json.Unmarshal(fields["total_count"], &totalCount); err != nil {
+		return 0
+	}
+
+	pageSize := listPageSize(query, itemCount, totalCount)
+	return (totalCount + pageSize - 1) / pageSize
+}
+
+// listPageSize returns the number of items per page of a list.
+// It is the page size of the request, unless the server capped it to fewer items than requested.
+// Without a page size in the request, the first page holds the default number of items of the server.
+func listPageSize(query url.Values, itemCount int, totalCount int) int {
+	for _, param := range []string{"page_size", "per_page"} {
+		requested, err := strconv.Atoi(query.Get(param))
+		if err != nil || requested <= 0 {
+			continue
+		}
+		if itemCount < requested && itemCount < totalCount {
+			return itemCount
+		}
+		return requested
+	}
+	return itemCount
+}
+
+// listItemCount returns the number of items of a page of a list.
+// A list response has a single array field holding the items of the page.
+func listItemCount(fields map[string]json.RawMessage) (int, bool) {
+	itemCount := -1
+	for _, field := range fields {
+		items := []json.RawMessage(nil)
+		if err := json.Unmarshal(field, &items); err != nil || items == nil {
+			continue
+		}
+		if itemCount != -1 {
+			return 0, false
+		}
+		itemCount = len(items)
+	}
+	return itemCount, itemCount != -1
+}
+
+// runWithParallelPages runs a command fetching the pages of its lists concurrently.
+func runWithParallelPages(ctx context.Context, runner func() (interface{}, error)) (interface{}, error) {
+	meta := extractMeta(ctx)
+	if meta.httpClient == nil {
+		return runner()
+	}
+
+	previousTransport := meta.httpClient.Transport
+	transport := previousTransport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	meta.httpClient.Transport = newParallelPagesTransport(transport)
+	defer func() {
+		meta.httpClient.Transport = previousTransport
+	}()
+
+	return runner()
+}
+
+// apiPageTransport fetches only the page requested with --page and --page-size.
+// The first list request is sent for the requested page. The total count of its response is recorded,
+// then replaced by its number of items so the SDK fetching every page of the list stops after it.
+type apiPageTransport struct {
+	transport http.RoundTripper
+	page      *Page
+
+	mu         sync.Mutex
+	fetched    bool
+	totalCount int
+}
+
+func (p *apiPageTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Method != http.MethodGet || !request.URL.Query().Has("page") {
+		return p.transport.RoundTrip(request)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.fetched {
+		return p.transport.RoundTrip(request)
+	}
+
+	request = request.Clone(request.Context())
+	query := request.URL.Query()
+	query.Set("page", strconv.Itoa(p.page.Number))
+	request.URL.RawQuery = query.Encode()
+
+	res, err := p.transport.RoundTrip(request)
+	if err != nil || res.StatusCode != http.StatusOK {
+		return res, err
+	}
+
+	body, err := readResponseBody(res)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return res, nil
+	}
+	itemCount, isList := listItemCount(fields)
+	if !isList {
+		return res, nil
+	}
+
+	// The instance API gives the total count in a header
+	totalCount := 0
+	if header := res.Header.Get("X-Total-Count"); header != "" {
+		totalCount, err = strconv.Atoi(header)
+		if err != nil {
+			return res, nil
+		}
+		res.Header.Set("X-Total-Count", strconv.Itoa(itemCount))
+	} else if err := json.Unmarshal(fields["total_count"], &totalCount); err != nil {
+		return res, nil
+	}
+	p.fetched = true
+	p.totalCount = totalCount
+
+	if _, exists := fields["total_count"]; exists {
+		fields["total_count"] = json.RawMessage(strconv.Itoa(itemCount))
+		body, err = json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+		res.Body = io.NopCloser(bytes.NewReader(body))
+		res.ContentLength = int64(len(body))
+		res.Header.Del("Content-Length")
+	}
+	return res, nil
+}
+
+// runWithAPIPage runs a list command fetching only the requested page from the API.
+// The result is returned as is if the command did not fetch a page of a list.
+func runWithAPIPage(ctx context.Context, page *Page, runner func() (interface{}, error)) (interface{}, error) {
+	meta := extractMeta(ctx)
+	if meta.httpClient == nil {
+		return runner()
+	}
+
+	previousTransport := meta.httpClient.Transport
+	transport := previousTransport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	pageTransport := &apiPageTransport{
+		transport: transport,
+		page:      page,
+	}
+	meta.httpClient.Transport = pageTransport
+	defer func() {
+		meta.httpClient.Transport = previousTransport
+	}()
+
+	data, err := runner()
+	if err != nil || !pageTransport.fetched {
+		return data, err
+	}
+
+	return &PagedResult{
+		Items:      data,
+		Page:       page.Number,
+		PageSize:   page.Size,
+		TotalCount: pageTransport.totalCount,
+		HasMore:    page.Number*page.Size < pageTransport.totalCount,
+	}, nil
+}
@@ -0,0 +1,163 @@
+package core_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+const (
+	testPagesTotalCount = 11
+	testPagesPageSize   = 2
+)
+
+type testPageResponse struct {
+	TotalCount int      `json:"total_count"`
+	Items      []string `json:"items"`
+}
+
+// testPagesServer is a paginated API recording the pages requested and the number of concurrent requests.
+type testPagesServer struct {
+	*httptest.Server
+
+	inFlight    int32
+	maxInFlight int32
+
+	// maxPageSize caps the page size requested, like the API does
+	maxPageSize int
+
+	mu             sync.Mutex
+	requestedPages map[int]int
+}
+
+func newTestPagesServer(t *testing.T) *testPagesServer {
+	t.Helper()
+	server := &testPagesServer{requestedPages: map[int]int{}}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&server.inFlight, 1)
+		defer atomic.AddInt32(&server.inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&server.maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&server.maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("page_size"))
+		if err != nil {
+			pageSize = testPagesPageSize
+		}
+		if server.maxPageSize > 0 {
+			pageSize = min(pageSize, server.maxPageSize)
+		}
+		server.mu.Lock()
+		server.requestedPages[page]++
+		server.mu.Unlock()
+
+		response := testPageResponse{TotalCount: testPagesTotalCount, Items: []string{}}
+		for i := (page - 1) * pageSize; i < min(page*pageSize, testPagesTotalCount); i++ {
+			response.Items = append(response.Items, fmt.Sprintf("item-%d", i))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func testPagesCommands(server *testPagesServer) *core.Commands {
+	return core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "list",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+				// Fetches the pages one after the other like the SDK does
+				items := []string(nil)
+				for page := 1; ; page++ {
+					request, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/items?page=%d", server.URL, page), nil)
+					if err != nil {
+						return nil, err
+					}
+					res, err := core.ExtractHTTPClient(ctx).Do(request)
+					if err != nil {
+						return nil, err
+					}
+					response := testPageResponse{}
+					err = json.NewDecoder(res.Body).Decode(&response)
+					_ = res.Body.Close()
+					if err != nil {
+						return nil, err
+					}
+					items = append(items, response.Items...)
+					if len(items) >= response.TotalCount {
+						return items, nil
+					}
+				}
+			},
+		},
+	)
+}
+
+func Test_ParallelPages(t *testing.T) {
+	checkItems := func(server *testPagesServer) core.TestCheck {
+		return func(t *testing.T, ctx *core.CheckFuncCtx) {
+			t.Helper()
+			items := ctx.Result.([]string)
+			assert.Len(t, items, testPagesTotalCount)
+			assert.Equal(t, "item-10", items[testPagesTotalCount-1])
+
+			server.mu.Lock()
+			defer server.mu.Unlock()
+			assert.Len(t, server.requestedPages, 6)
+			for page, count := range server.requestedPages {
+				assert.Equal(t, 1, count, "page %d", page)
+			}
+		}
+	}
+
+	t.Run("Pages fetched concurrently with --all", func(t *testing.T) {
+		server := newTestPagesServer(t)
+		core.Test(&core.TestConfig{
+			Commands: testPagesCommands(server),
+			Cmd:      "scw test item list --all",
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(0),
+				checkItems(server),
+				func(t *testing.T, _ *core.CheckFuncCtx) {
+					assert.True(t, atomic.LoadInt32(&server.maxInFlight) > 1)
+				},
+			),
+		})(t)
+	})
+
+	t.Run("Pages fetched one after the other", func(t *testing.T) {
+		server := newTestPagesServer(t)
+		core.Test(&core.TestConfig{
+			Commands: testPagesCommands(server),
+			Cmd:      "scw test item list",
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(0),
+				checkItems(server),
+				func(t *testing.T, _ *core.CheckFuncCtx) {
+					assert.Equal(t, int32(1), atomic.LoadInt32(&server.maxInFlight))
+				},
+			),
+		})(t)
+	})
+}
@@ -1,21 +1,111 @@
 package core
 
 import (
+	"bytes"
+	"io"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"time"
 )
 
-const defaultRetryInterval = 1 * time.Second
+const (
+	defaultRetryInterval = 1 * time.Second
+	maxRetryInterval     = 30 * time.Second
+	defaultMaxRetries    = 3
+)
 
+// retryableHTTPTransport retries the requests failing because of rate limiting or of a transient API error.
+// Attempts are spaced by a jittered exponential backoff, or by the delay the API asks for in the Retry-After header.
 type retryableHTTPTransport struct {
-	transport http.RoundTripper
+	transport  http.RoundTripper
+	maxRetries int
+}
+
+func newRetryableHTTPTransport(transport http.RoundTripper) *retryableHTTPTransport {
+	return &retryableHTTPTransport{
+		transport:  transport,
+		maxRetries: defaultMaxRetries,
+	}
 }
 
 func (r *retryableHTTPTransport) RoundTrip(request *http.Request) (*http.Response, error) {
-	res, err := r.transport.RoundTrip(request)
-	if err == nil && res.StatusCode == http.StatusTooManyRequests {
-		time.Sleep(defaultRetryInterval)
-		return r.RoundTrip(request)
+	// The body is kept so it can be sent again, each attempt sends a copy of the request with a new body
+	getBody := request.GetBody
+	if request.Body != nil && request.Body != http.NoBody {
+		if getBody == nil {
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				_ = request.Body.Close()
+				return nil, err
+			}
+			getBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+		_ = request.Body.Close()
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptRequest := request.Clone(request.Context())
+		if getBody != nil {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptRequest.Body = body
+			attemptRequest.GetBody = getBody
+		}
+
+		res, err := r.transport.RoundTrip(attemptRequest)
+		if err != nil || attempt >= r.maxRetries || !isRetryableResponse(request, res) {
+			return res, err
+		}
+
+		delay := retryDelay(res, attempt)
+		_, _ = io.Copy(io.Discard, res.Body)
+		_ = res.Body.Close()
+
+		select {
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// isRetryableResponse reports whether a response is a rate limit or a transient error worth another attempt.
+func isRetryableResponse(request *http.Request, res *http.Response) bool {
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		// The request may have been processed, only the requests that can safely be sent twice are retried
+		switch request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+			return true
+		}
+	}
+	return false
+}
+
+// retryDelay returns the delay asked by the Retry-After header of the response, capped to maxRetryInterval,
+// or an exponential backoff with jitter when the header is missing.
+func retryDelay(res *http.Response, attempt int) time.Duration {
+	if retryAfter := res.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			// Large values are capped before the conversion which could overflow
+			return time.Duration(min(seconds, int(maxRetryInterval/time.Second))) * time.Second
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return min(max(time.Until(date), 0), maxRetryInterval)
+		}
+	}
+
+	// The shift is bounded as the backoff reaches its maximum after a few attempts
+	backoff := maxRetryInterval
+	if attempt < 5 {
+		backoff = min(defaultRetryInterval<<attempt, maxRetryInterval)
 	}
-	return res, err
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1)) //nolint:gosec
 }
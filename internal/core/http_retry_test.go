@@ -0,0 +1,92 @@
+package core_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+func Test_RetryableHTTPTransport(t *testing.T) {
+	var attempts int32
+	var failures int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"name":"a"}`, string(body))
+		if atomic.AddInt32(&attempts, 1) <= atomic.LoadInt32(&failures) {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	commands := core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "create",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+				request, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/items", bytes.NewBufferString(`{"name":"a"}`))
+				if err != nil {
+					return nil, err
+				}
+				body := request.Body
+				res, err := core.ExtractHTTPClient(ctx).Do(request)
+				if err != nil {
+					return nil, err
+				}
+				_ = res.Body.Close()
+				if request.Body != body {
+					return nil, errors.New("the request of the caller was modified")
+				}
+				return &core.SuccessResult{Message: res.Status}, nil
+			},
+		},
+	)
+
+	t.Run("Retry rate limited request", core.Test(&core.TestConfig{
+		Commands: commands,
+		BeforeFunc: func(_ *core.BeforeFuncCtx) error {
+			atomic.StoreInt32(&attempts, 0)
+			atomic.StoreInt32(&failures, 2)
+			return nil
+		},
+		Cmd: "scw test item create",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+				assert.Contains(t, string(ctx.Stdout), "200 OK")
+			},
+		),
+	}))
+
+	t.Run("Give up after max retries", core.Test(&core.TestConfig{
+		Commands: commands,
+		BeforeFunc: func(_ *core.BeforeFuncCtx) error {
+			atomic.StoreInt32(&attempts, 0)
+			atomic.StoreInt32(&failures, 10)
+			return nil
+		},
+		Cmd: "scw test item create",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, int32(4), atomic.LoadInt32(&attempts))
+				assert.Contains(t, string(ctx.Stdout), "429 Too Many Requests")
+			},
+		),
+	}))
+}
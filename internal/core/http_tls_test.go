@@ -0,0 +1,29 @@
+package core_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+func Test_TLSOptions(t *testing.T) {
+	t.Run("HTTP client given in the config", core.Test(&core.TestConfig{
+		Commands: core.NewCommands(&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "get",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (i interface{}, e error) {
+				return &core.SuccessResult{}, nil
+			},
+		}),
+		Cmd: "scw test item get --insecure-skip-verify",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+	}))
+}
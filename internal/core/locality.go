@@ -0,0 +1,137 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/args"
+	"github.com/scaleway/scaleway-cli/v2/internal/gofields"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// localityArg is an argument selecting the zone or the region of a command.
+type localityArg struct {
+	// Name is the name of the argument.
+	Name string
+	// FieldName is the field holding the locality in the listed resources.
+	FieldName string
+	// All returns every locality, used when the argument spec does not list them.
+	All func() []string
+}
+
+var localityArgs = []*localityArg{
+	{
+		Name:      "zone",
+		FieldName: "Zone",
+		All: func() []string {
+			zones := make([]string, 0, len(scw.AllZones))
+			for _, zone := range scw.AllZones {
+				zones = append(zones, zone.String())
+			}
+			return zones
+		},
+	},
+	{
+		Name:      "region",
+		FieldName: "Region",
+		All: func() []string {
+			regions := make([]string, 0, len(scw.AllRegions))
+			for _, region := range scw.AllRegions {
+				regions = append(regions, region.String())
+			}
+			return regions
+		},
+	},
+}
+
+// getAllLocalitiesArg returns the locality argument of a list command set to all, or nil.
+func getAllLocalitiesArg(cmd *Command, rawArgs args.RawArgs) *localityArg {
+	if !commandIsList(cmd) {
+		return nil
+	}
+	for _, locality := range localityArgs {
+		if value, exists := rawArgs.Get(locality.Name); exists && value == AllLocalities && cmd.ArgSpecs.GetByName(locality.Name) != nil {
+			return locality
+		}
+	}
+	return nil
+}
+
+// fanOutLocalities returns the localities a list command must be run in to list all of them.
+// Commands accepting all as a locality list every locality with a single call, nil is returned for them.
+func fanOutLocalities(cmd *Command, locality *localityArg) []string {
+	argSpec := cmd.ArgSpecs.GetByName(locality.Name)
+	localities := []string(nil)
+	for _, value := range argSpec.EnumValues {
+		if value == AllLocalities {
+			return nil
+		}
+		localities = append(localities, value)
+	}
+	if len(localities) == 0 {
+		return locality.All()
+	}
+	return localities
+}
+
+// runInLocalities runs a list command concurrently in each locality and merges the listed resources.
+func runInLocalities(ctx context.Context, cmdArgs interface{}, locality *localityArg, localities []string, runner CommandRunner) (interface{}, error) {
+	results := make([]interface{}, len(localities))
+	errs := make([]error, len(localities))
+	done := make(chan struct{})
+	for i, value := range localities {
+		go func(i int, value string) {
+			defer func() { done <- struct{}{} }()
+
+			localityArgs := reflect.New(reflect.TypeOf(cmdArgs).Elem())
+			localityArgs.Elem().Set(reflect.ValueOf(cmdArgs).Elem())
+			errs[i] = args.UnmarshalStruct([]string{locality.Name + "=" + value}, localityArgs.Interface())
+			if errs[i] != nil {
+				return
+			}
+			results[i], errs[i] = runner(ctx, localityArgs.Interface())
+		}(i, value)
+	}
+	for range localities {
+		<-done
+	}
+
+	var merged reflect.Value
+	for i, result := range results {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if result == nil {
+			continue
+		}
+		value := reflect.ValueOf(result)
+		if value.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("cannot merge a result of type %T, a slice is expected", result)
+		}
+		if !merged.IsValid() {
+			merged = reflect.MakeSlice(value.Type(), 0, value.Len())
+		}
+		merged = reflect.AppendSlice(merged, value)
+	}
+	if !merged.IsValid() {
+		return nil, nil
+	}
+	return merged.Interface(), nil
+}
+
+// localityColumn returns the field to show as a column when a list holds the resources of every locality,
+// or "" if the listed resources do not have it.
+func localityColumn(locality *localityArg, result interface{}) string {
+	if pagedResult, isPaged := result.(*PagedResult); isPaged {
+		result = pagedResult.Items
+	}
+	resultType := reflect.TypeOf(result)
+	if resultType == nil || resultType.Kind() != reflect.Slice {
+		return ""
+	}
+	if _, err := gofields.GetType(resultType.Elem(), locality.FieldName); err != nil {
+		return ""
+	}
+	return locality.FieldName
+}
@@ -0,0 +1,151 @@
+package core_test
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+type testLocalityServer struct {
+	Name string
+	Zone scw.Zone
+}
+
+type testLocalityListArgs struct {
+	Zone scw.Zone
+}
+
+type testLocalityCluster struct {
+	Name   string
+	Region scw.Region
+}
+
+type testLocalityRegionalListArgs struct {
+	Region scw.Region
+}
+
+func testLocalityCommands(runs *int32) *core.Commands {
+	list := func(_ context.Context, argsI interface{}) (i interface{}, e error) {
+		atomic.AddInt32(runs, 1)
+		args := argsI.(*testLocalityListArgs)
+		if args.Zone == scw.Zone(core.AllLocalities) {
+			return []*testLocalityServer{
+				{Name: "web", Zone: scw.ZoneFrPar1},
+				{Name: "db", Zone: scw.ZoneFrPar2},
+			}, nil
+		}
+		return []*testLocalityServer{
+			{Name: "server-" + args.Zone.String(), Zone: args.Zone},
+		}, nil
+	}
+
+	return core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "server",
+			Verb:                 "list",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(testLocalityListArgs{}),
+			ArgSpecs: core.ArgSpecs{
+				core.ZoneArgSpec(scw.ZoneFrPar1, scw.ZoneFrPar2, scw.ZoneNlAms1),
+			},
+			View: &core.View{
+				Fields: []*core.ViewField{
+					{FieldName: "Name", Label: "Name"},
+				},
+			},
+			Run: list,
+		},
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "volume",
+			Verb:                 "list",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(testLocalityListArgs{}),
+			ArgSpecs: core.ArgSpecs{
+				core.ZoneArgSpec(scw.ZoneFrPar1, scw.ZoneFrPar2, scw.Zone(core.AllLocalities)),
+			},
+			Run: list,
+		},
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "cluster",
+			Verb:                 "list",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(testLocalityRegionalListArgs{}),
+			ArgSpecs: core.ArgSpecs{
+				core.RegionArgSpec(scw.RegionFrPar, scw.RegionNlAms),
+			},
+			View: &core.View{
+				Fields: []*core.ViewField{
+					{FieldName: "Name", Label: "Name"},
+				},
+			},
+			Run: func(_ context.Context, argsI interface{}) (i interface{}, e error) {
+				atomic.AddInt32(runs, 1)
+				args := argsI.(*testLocalityRegionalListArgs)
+				return []*testLocalityCluster{
+					{Name: "cluster-" + args.Region.String(), Region: args.Region},
+				}, nil
+			},
+		},
+	)
+}
+
+func Test_AllZones(t *testing.T) {
+	t.Run("Run in each zone", func(t *testing.T) {
+		runs := int32(0)
+		core.Test(&core.TestConfig{
+			Commands: testLocalityCommands(&runs),
+			Cmd:      "scw test server list zone=all",
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(0),
+				core.TestCheckGolden(),
+				func(t *testing.T, ctx *core.CheckFuncCtx) {
+					assert.Equal(t, int32(3), atomic.LoadInt32(&runs))
+					servers := ctx.Result.([]*testLocalityServer)
+					assert.Equal(t, 3, len(servers))
+					assert.Equal(t, "server-nl-ams-1", servers[2].Name)
+				},
+			),
+		})(t)
+	})
+
+	t.Run("Listed at once by the command", func(t *testing.T) {
+		runs := int32(0)
+		core.Test(&core.TestConfig{
+			Commands: testLocalityCommands(&runs),
+			Cmd:      "scw test volume list zone=all",
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(0),
+				func(t *testing.T, ctx *core.CheckFuncCtx) {
+					assert.Equal(t, int32(1), atomic.LoadInt32(&runs))
+					assert.Len(t, ctx.Result, 2)
+				},
+			),
+		})(t)
+	})
+}
+
+func Test_AllRegions(t *testing.T) {
+	runs := int32(0)
+	t.Run("Run in each region", core.Test(&core.TestConfig{
+		Commands: testLocalityCommands(&runs),
+		Cmd:      "scw test cluster list region=all",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, int32(2), atomic.LoadInt32(&runs))
+				clusters := ctx.Result.([]*testLocalityCluster)
+				assert.Equal(t, 2, len(clusters))
+				assert.Equal(t, scw.RegionNlAms, clusters[1].Region)
+			},
+		),
+	}))
+}
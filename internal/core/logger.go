@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/scaleway/scaleway-cli/v2/internal/redact"
 	"github.com/scaleway/scaleway-sdk-go/logger"
 )
 
+// Logger writes the CLI logs, the registered secrets are masked in every message.
 type Logger struct {
 	writer io.Writer
 	level  logger.LogLevel
@@ -14,49 +16,49 @@ type Logger struct {
 
 func (l *Logger) Debugf(format string, args ...interface{}) {
 	if l.ShouldLog(logger.LogLevelDebug) {
-		_, _ = fmt.Fprintf(l.writer, format, args...)
+		_, _ = fmt.Fprint(l.writer, redact.String(fmt.Sprintf(format, args...)))
 	}
 }
 
 func (l *Logger) Infof(format string, args ...interface{}) {
 	if l.ShouldLog(logger.LogLevelInfo) {
-		_, _ = fmt.Fprintf(l.writer, format, args...)
+		_, _ = fmt.Fprint(l.writer, redact.String(fmt.Sprintf(format, args...)))
 	}
 }
 
 func (l *Logger) Warningf(format string, args ...interface{}) {
 	if l.ShouldLog(logger.LogLevelWarning) {
-		_, _ = fmt.Fprintf(l.writer, format, args...)
+		_, _ = fmt.Fprint(l.writer, redact.String(fmt.Sprintf(format, args...)))
 	}
 }
 
 func (l *Logger) Errorf(format string, args ...interface{}) {
 	if l.ShouldLog(logger.LogLevelError) {
-		_, _ = fmt.Fprintf(l.writer, format, args...)
+		_, _ = fmt.Fprint(l.writer, redact.String(fmt.Sprintf(format, args...)))
 	}
 }
 
 func (l *Logger) Debug(args ...interface{}) {
 	if l.ShouldLog(logger.LogLevelDebug) {
-		_, _ = fmt.Fprintln(l.writer, args...)
+		_, _ = fmt.Fprint(l.writer, redact.String(fmt.Sprintln(args...)))
 	}
 }
 
 func (l *Logger) Info(args ...interface{}) {
 	if l.ShouldLog(logger.LogLevelInfo) {
-		_, _ = fmt.Fprintln(l.writer, args...)
+		_, _ = fmt.Fprint(l.writer, redact.String(fmt.Sprintln(args...)))
 	}
 }
 
 func (l *Logger) Warning(args ...interface{}) {
 	if l.ShouldLog(logger.LogLevelWarning) {
-		_, _ = fmt.Fprintln(l.writer, args...)
+		_, _ = fmt.Fprint(l.writer, redact.String(fmt.Sprintln(args...)))
 	}
 }
 
 func (l *Logger) Error(args ...interface{}) {
 	if l.ShouldLog(logger.LogLevelError) {
-		_, _ = fmt.Fprintln(l.writer, args...)
+		_, _ = fmt.Fprint(l.writer, redact.String(fmt.Sprintln(args...)))
 	}
 }
 
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/pkg/shlex"
+	"github.com/scaleway/scaleway-cli/v2/internal/terminal"
+	"github.com/scaleway/scaleway-sdk-go/logger"
+)
+
+// defaultPager is the pager used when the PAGER env variable is not set
+const defaultPager = "less -R"
+
+// usePager returns whether content must be shown through a pager.
+// Paging must be enabled, the printer must write to a terminal and content must not fit in it.
+func (p *Printer) usePager(content string) bool {
+	if !p.pager || p.stdout != io.Writer(os.Stdout) || !terminal.IsTerm() {
+		return false
+	}
+	height := terminal.GetHeight()
+	return height > 0 && strings.Count(content, "\n") >= height
+}
+
+// printWithPager shows content through the pager of the user.
+// Content is printed directly if the pager cannot be started.
+func (p *Printer) printWithPager(content string) error {
+	pager := os.Getenv("PAGER")
+	if pager == "" {
+		pager = defaultPager
+	}
+
+	pagerArgs, err := shlex.Split(pager)
+	if err != nil || len(pagerArgs) == 0 {
+		logger.Debugf("invalid pager %q: %v", pager, err)
+		_, err = fmt.Fprint(p.stdout, content)
+		return err
+	}
+
+	cmd := exec.Command(pagerArgs[0], pagerArgs[1:]...) //nolint:gosec
+	cmd.Stdin = strings.NewReader(content)
+	cmd.Stdout = p.stdout
+	cmd.Stderr = p.stderr
+	err = cmd.Start()
+	if err != nil {
+		logger.Debugf("cannot start pager %q: %v", pager, err)
+		_, err = fmt.Fprint(p.stdout, content)
+		return err
+	}
+
+	// The exit status of the pager does not matter, content has been shown
+	_ = cmd.Wait()
+	return nil
+}
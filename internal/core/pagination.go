@@ -0,0 +1,188 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/human"
+	"github.com/spf13/cobra"
+)
+
+// Page is the page of a list requested with the --page and --page-size flags.
+type Page struct {
+	// Number is the page number, starting at 1.
+	Number int
+	// Size is the maximum number of items in the page.
+	Size int
+}
+
+// PagedResult is a single page of the result of a paginated command.
+// It is built by the core from the page fetched from the API, or from the slice returned by Run when the API page could not be requested.
+type PagedResult struct {
+	Items      interface{}
+	Page       int
+	PageSize   int
+	TotalCount int
+	HasMore    bool
+}
+
+func init() {
+	human.RegisterMarshalerFunc(&PagedResult{}, func(i interface{}, opt *human.MarshalOpt) (string, error) {
+		result := i.(*PagedResult)
+		str, err := human.Marshal(result.Items, opt)
+		if err != nil {
+			return "", err
+		}
+		if result.HasMore {
+			str += fmt.Sprintf("\n\nPage %d, %d items in total. More items are available, use --page %d to show them.", result.Page, result.TotalCount, result.Page+1)
+		}
+		return str, nil
+	})
+}
+
+func (r *PagedResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Items      interface{} `json:"items"`
+		Page       int         `json:"page"`
+		PageSize   int         `json:"page_size"`
+		TotalCount int         `json:"total_count"`
+		HasMore    bool        `json:"has_more"`
+	}{
+		Items:      r.Items,
+		Page:       r.Page,
+		PageSize:   r.PageSize,
+		TotalCount: r.TotalCount,
+		HasMore:    r.HasMore,
+	})
+}
+
+// commandIsPaginated reports whether a command has the pagination flags.
+// Every list command is paginated, other commands can opt in with Paginated.
+func commandIsPaginated(cmd *Command) bool {
+	return cmd.Paginated || (cmd.Run != nil && cmd.Verb == "list")
+}
+
+func addPaginationFlags(cobraCmd *cobra.Command) {
+	cobraCmd.PersistentFlags().Int("page", 1, "page number to show, used with --page-size")
+	cobraCmd.PersistentFlags().Int("page-size", 0, "maximum number of items to fetch and show, all items are shown if not set, the JSON output is then an object with the page in items")
+	cobraCmd.PersistentFlags().Bool("all", false, "fetch every page of the list concurrently, cannot be used with --page or --page-size")
+}
+
+// getAllPagesFromFlags returns whether every page of the list was requested with --all.
+func getAllPagesFromFlags(cobraCmd *cobra.Command, cmd *Command) bool {
+	if !commandIsPaginated(cmd) {
+		return false
+	}
+	all, err := cobraCmd.PersistentFlags().GetBool("all")
+	return err == nil && all
+}
+
+// getPageFromFlags returns the page requested with flags, or nil if the whole list was requested with --all or without --page-size.
+func getPageFromFlags(cobraCmd *cobra.Command, cmd *Command) (*Page, error) {
+	if !commandIsPaginated(cmd) {
+		return nil, nil
+	}
+
+	flags := cobraCmd.PersistentFlags()
+	if getAllPagesFromFlags(cobraCmd, cmd) {
+		if flags.Changed("page") || flags.Changed("page-size") {
+			return nil, &CliError{
+				Err:  errors.New("--all cannot be used with --page or --page-size"),
+				Hint: "remove --all to show a single page, or remove --page and --page-size to show the whole list",
+			}
+		}
+		return nil, nil
+	}
+
+	pageSize, err := flags.GetInt("page-size")
+	if err != nil || pageSize == 0 {
+		return nil, nil
+	}
+	pageNumber, err := flags.GetInt("page")
+	if err != nil {
+		return nil, err
+	}
+	if pageSize < 0 || pageNumber < 1 {
+		return nil, &CliError{
+			Err:  fmt.Errorf("invalid pagination: page %d of size %d", pageNumber, pageSize),
+			Hint: "page must be greater than 0 and page-size must be positive",
+		}
+	}
+
+	return &Page{
+		Number: pageNumber,
+		Size:   pageSize,
+	}, nil
+}
+
+// setRequestPage sets the requested page on the list request of a command.
+// It returns false if the request has no page size field, the page cannot be requested from the API then.
+func setRequestPage(request interface{}, page *Page) bool {
+	value := reflect.ValueOf(request)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	value = value.Elem()
+
+	// The instance API names the page size per_page
+	pageSizeField := value.FieldByName("PageSize")
+	if !pageSizeField.IsValid() {
+		pageSizeField = value.FieldByName("PerPage")
+	}
+	if !setIntPointerField(pageSizeField, page.Size) {
+		return false
+	}
+	setIntPointerField(value.FieldByName("Page"), page.Number)
+	return true
+}
+
+// setIntPointerField sets a pointer to an integer field, it returns false if the field is not one.
+func setIntPointerField(field reflect.Value, number int) bool {
+	if !field.IsValid() || !field.CanSet() || field.Kind() != reflect.Ptr {
+		return false
+	}
+	pointer := reflect.New(field.Type().Elem())
+	switch pointer.Elem().Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		pointer.Elem().SetInt(int64(number))
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		pointer.Elem().SetUint(uint64(number))
+	default:
+		return false
+	}
+	field.Set(pointer)
+	return true
+}
+
+// paginateResult extracts the requested page from a list result.
+// Results already paginated from the API are returned as is.
+func paginateResult(result interface{}, page *Page) (interface{}, error) {
+	if pagedResult, isPaged := result.(*PagedResult); isPaged {
+		return pagedResult, nil
+	}
+
+	value := reflect.ValueOf(result)
+	if value.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("cannot paginate a result of type %T, a slice is expected", result)
+	}
+
+	total := value.Len()
+	start := (page.Number - 1) * page.Size
+	if start > total {
+		start = total
+	}
+	end := start + page.Size
+	if end > total {
+		end = total
+	}
+
+	return &PagedResult{
+		Items:      value.Slice(start, end).Interface(),
+		Page:       page.Number,
+		PageSize:   page.Size,
+		TotalCount: total,
+		HasMore:    end < total,
+	}, nil
+}
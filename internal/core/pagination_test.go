@@ -0,0 +1,214 @@
+package core_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPaginationItem struct {
+	Name string
+}
+
+func testPaginationCommands() *core.Commands {
+	items := []*testPaginationItem{{"a"}, {"b"}, {"c"}, {"d"}, {"e"}}
+
+	return core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "list",
+			AllowAnonymousClient: true,
+			Paginated:            true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (i interface{}, e error) {
+				return items, nil
+			},
+		},
+	)
+}
+
+type testAPIPageRequest struct {
+	Page     *int32
+	PageSize *uint32
+}
+
+// testAPIPageResponse is a list response fetched with the SDK like the ones of generated commands.
+type testAPIPageResponse struct {
+	TotalCount uint64   `json:"total_count"`
+	Items      []string `json:"items"`
+}
+
+func (r *testAPIPageResponse) UnsafeGetTotalCount() uint64 {
+	return r.TotalCount
+}
+
+func (r *testAPIPageResponse) UnsafeAppend(res interface{}) (uint64, error) {
+	results, ok := res.(*testAPIPageResponse)
+	if !ok {
+		return 0, fmt.Errorf("%T type cannot be appended to type %T", res, r)
+	}
+
+	r.Items = append(r.Items, results.Items...)
+	r.TotalCount += uint64(len(results.Items))
+	return uint64(len(results.Items)), nil
+}
+
+func testAPIPaginationCommands(server *testPagesServer) *core.Commands {
+	return core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "list",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(testAPIPageRequest{}),
+			Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+				request := argsI.(*testAPIPageRequest)
+				client, err := scw.NewClient(
+					scw.WithAPIURL(server.URL),
+					scw.WithoutAuth(),
+					scw.WithHTTPClient(core.ExtractHTTPClient(ctx)),
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				query := url.Values{}
+				if request.Page != nil {
+					query.Set("page", strconv.Itoa(int(*request.Page)))
+				}
+				if request.PageSize != nil {
+					query.Set("page_size", strconv.Itoa(int(*request.PageSize)))
+				}
+				response := &testAPIPageResponse{}
+				err = client.Do(&scw.ScalewayRequest{
+					Method: http.MethodGet,
+					Path:   "/items",
+					Query:  query,
+				}, response, scw.WithAllPages())
+				if err != nil {
+					return nil, err
+				}
+				return response.Items, nil
+			},
+		},
+	)
+}
+
+func Test_Pagination(t *testing.T) {
+	t.Run("Page size", core.Test(&core.TestConfig{
+		Commands: testPaginationCommands(),
+		Cmd:      "scw test item list --page-size 2",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				result := ctx.Result.(*core.PagedResult)
+				assert.Len(t, result.Items, 2)
+				assert.True(t, result.HasMore)
+			},
+		),
+	}))
+
+	t.Run("Last page", core.Test(&core.TestConfig{
+		Commands: testPaginationCommands(),
+		Cmd:      "scw test item list --page-size 2 --page 3",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				result := ctx.Result.(*core.PagedResult)
+				assert.Equal(t, []*testPaginationItem{{"e"}}, result.Items)
+				assert.False(t, result.HasMore)
+			},
+		),
+	}))
+
+	t.Run("No page size", core.Test(&core.TestConfig{
+		Commands: testPaginationCommands(),
+		Cmd:      "scw test item list",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Len(t, ctx.Result, 5)
+			},
+		),
+	}))
+
+	t.Run("Page fetched from the API", func(t *testing.T) {
+		server := newTestPagesServer(t)
+		core.Test(&core.TestConfig{
+			Commands: testAPIPaginationCommands(server),
+			Cmd:      "scw test item list --page-size 3 --page 2",
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(0),
+				func(t *testing.T, ctx *core.CheckFuncCtx) {
+					result := ctx.Result.(*core.PagedResult)
+					assert.Equal(t, []string{"item-3", "item-4", "item-5"}, result.Items)
+					assert.Equal(t, 11, result.TotalCount)
+					assert.True(t, result.HasMore)
+
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					assert.Equal(t, map[int]int{2: 1}, server.requestedPages)
+				},
+			),
+		})(t)
+	})
+
+	t.Run("All pages", core.Test(&core.TestConfig{
+		Commands: testPaginationCommands(),
+		Cmd:      "scw test item list --all",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Len(t, ctx.Result, 5)
+			},
+		),
+	}))
+
+	t.Run("All pages with page size", core.Test(&core.TestConfig{
+		Commands: testPaginationCommands(),
+		Cmd:      "scw test item list --all --page-size 2",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("All pages with a page size capped by the API", func(t *testing.T) {
+		server := newTestPagesServer(t)
+		server.maxPageSize = 4
+		core.Test(&core.TestConfig{
+			Commands: testAPIPaginationCommands(server),
+			Cmd:      "scw test item list page-size=10 --all",
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(0),
+				func(t *testing.T, ctx *core.CheckFuncCtx) {
+					assert.Len(t, ctx.Result, 11)
+
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					assert.Equal(t, map[int]int{1: 1, 2: 1, 3: 1}, server.requestedPages)
+				},
+			),
+		})(t)
+	})
+
+	t.Run("Invalid page", core.Test(&core.TestConfig{
+		Commands: testPaginationCommands(),
+		Cmd:      "scw test item list --page-size 2 --page 0",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+	}))
+}
@@ -0,0 +1,115 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/args"
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+	"github.com/scaleway/scaleway-sdk-go/strcase"
+)
+
+// pickMissingResourceIDs asks the user to select the resources of the required ID arguments missing from rawArgs.
+// It is only done in interactive sessions, commands fail on the missing arguments otherwise.
+func pickMissingResourceIDs(ctx context.Context, cmd *Command, rawArgs args.RawArgs) (args.RawArgs, error) {
+	if !interactive.IsInteractive {
+		return rawArgs, nil
+	}
+
+	for _, argSpec := range cmd.ArgSpecs {
+		if !argSpec.Required || argSpec.OneOfGroup != "" || !strings.HasSuffix(argSpec.Name, "id") || strings.Contains(argSpec.Name, "{") {
+			continue
+		}
+		if rawArgs.Has(argSpec.Name) || argSpec.Positional && len(rawArgs.GetPositionalArgs()) > 0 {
+			continue
+		}
+
+		id, err := pickResourceID(ctx, cmd, argSpec, rawArgs)
+		if err != nil {
+			return nil, err
+		}
+		// Nothing to select from, the missing argument is reported as usual
+		if id == "" {
+			continue
+		}
+
+		if argSpec.Positional {
+			rawArgs = append(rawArgs, id)
+		} else {
+			rawArgs = rawArgs.Add(argSpec.Name, id)
+		}
+	}
+
+	return rawArgs, nil
+}
+
+// pickResourceID lists the resources an argument refers to and asks the user to select one of them.
+// The resources are listed in the zone or region of the command.
+func pickResourceID(ctx context.Context, cmd *Command, argSpec *ArgSpec, rawArgs args.RawArgs) (string, error) {
+	listCmd, fieldName := findArgListCommand(ctx, cmd, argSpec)
+	if listCmd == nil || listCmd.Run == nil {
+		return "", nil
+	}
+
+	completedArgs := map[string]string{}
+	for _, locality := range localityArgs {
+		if value, exists := rawArgs.Get(locality.Name); exists {
+			completedArgs[locality.Name+"="] = value
+		}
+	}
+	listRawArgs := ApplyDefaultValues(ctx, listCmd.ArgSpecs, listRawArgsLocalities(completedArgs, listCmd))
+	listCmdArgs := reflect.New(listCmd.ArgsType).Interface()
+	err := args.UnmarshalStruct(listRawArgs, listCmdArgs)
+	if err != nil {
+		return "", err
+	}
+
+	var resp interface{}
+	if listCmd.Interceptor != nil {
+		resp, err = listCmd.Interceptor(ctx, listCmdArgs, listCmd.Run)
+	} else {
+		resp, err = listCmd.Run(ctx, listCmdArgs)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	resources := reflect.ValueOf(resp)
+	if resources.Kind() != reflect.Slice {
+		return "", nil
+	}
+	ids := []string(nil)
+	choices := []string(nil)
+	for i := 0; i < resources.Len(); i++ {
+		resource := reflect.Indirect(resources.Index(i))
+		if resource.Kind() != reflect.Struct {
+			continue
+		}
+		id := resource.FieldByName(strcase.ToPublicGoName(fieldName))
+		if id.Kind() != reflect.String || id.String() == "" {
+			continue
+		}
+		choice := id.String()
+		if name := resource.FieldByName("Name"); name.Kind() == reflect.String && name.String() != "" {
+			choice = fmt.Sprintf("%s (%s)", name.String(), id.String())
+		}
+		ids = append(ids, id.String())
+		choices = append(choices, choice)
+	}
+	if len(ids) == 0 {
+		return "", nil
+	}
+
+	prompt := interactive.ListPrompt{
+		Prompt:  fmt.Sprintf("Select the %s of %s", argSpec.Name, cmd.GetCommandLine(extractMeta(ctx).BinaryName)),
+		Choices: choices,
+		Filter:  true,
+	}
+	index, err := prompt.Execute(ctx)
+	if err != nil {
+		return "", err
+	}
+	return ids[index], nil
+}
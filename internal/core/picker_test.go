@@ -0,0 +1,88 @@
+package core_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+)
+
+type testPickerServer struct {
+	ID   string
+	Name string
+}
+
+type testPickerGetArgs struct {
+	ServerID string
+}
+
+func testPickerCommands() *core.Commands {
+	return core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "server",
+			Verb:                 "list",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (i interface{}, e error) {
+				return []*testPickerServer{
+					{ID: "11111111-1111-1111-1111-111111111111", Name: "web"},
+					{ID: "22222222-2222-2222-2222-222222222222", Name: "db"},
+				}, nil
+			},
+		},
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "server",
+			Verb:                 "get",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(testPickerGetArgs{}),
+			ArgSpecs: core.ArgSpecs{
+				{
+					Name:       "server-id",
+					Required:   true,
+					Positional: true,
+				},
+			},
+			Run: func(_ context.Context, argsI interface{}) (i interface{}, e error) {
+				return argsI.(*testPickerGetArgs).ServerID, nil
+			},
+		},
+	)
+}
+
+func Test_PickMissingResourceID(t *testing.T) {
+	t.Run("Interactive", func(t *testing.T) {
+		interactive.IsInteractive = true
+		t.Cleanup(func() {
+			interactive.IsInteractive = false
+		})
+
+		core.Test(&core.TestConfig{
+			Commands:            testPickerCommands(),
+			Cmd:                 "scw test server get",
+			PromptResponseMocks: []string{"db\r"},
+			DisableParallel:     true,
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(0),
+				func(t *testing.T, ctx *core.CheckFuncCtx) {
+					assert.Equal(t, "22222222-2222-2222-2222-222222222222", ctx.Result)
+				},
+			),
+		})(t)
+	})
+
+	t.Run("Not interactive", core.Test(&core.TestConfig{
+		Commands: testPickerCommands(),
+		Cmd:      "scw test server get",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Nil(t, ctx.Result)
+			},
+		),
+	}))
+}
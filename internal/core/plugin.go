@@ -0,0 +1,78 @@
+package core
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	cliConfig "github.com/scaleway/scaleway-cli/v2/internal/config"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// pluginPrefix is the prefix of the executables providing the commands unknown to the CLI,
+// e.g. scw-foo on the PATH provides scw foo.
+const pluginPrefix = "scw-"
+
+// findPlugin returns the path of the plugin providing the command of args and the arguments to pass to it,
+// or "" if the command is known or no plugin provides it.
+// Global flags given before the command are handled by the CLI and not passed to the plugin.
+func findPlugin(ctx context.Context, rootCmd *cobra.Command, args []string) (string, []string) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			if cmd, _, err := rootCmd.Find(args[i : i+1]); err == nil && cmd != rootCmd {
+				return "", nil
+			}
+			path := lookPluginPath(ctx, pluginPrefix+arg)
+			if path == "" {
+				return "", nil
+			}
+			return path, args[i+1:]
+		}
+
+		// Skip the value of flags given as --flag value
+		if strings.Contains(arg, "=") {
+			continue
+		}
+		var flag *pflag.Flag
+		if name, isLong := strings.CutPrefix(arg, "--"); isLong {
+			flag = rootCmd.PersistentFlags().Lookup(name)
+		} else if len(arg) == 2 {
+			flag = rootCmd.PersistentFlags().ShorthandLookup(arg[1:])
+		}
+		if flag != nil && flag.NoOptDefVal == "" {
+			i++
+		}
+	}
+	return "", nil
+}
+
+// lookPluginPath returns the path of an executable in the directories of the PATH, or "" if there is none.
+func lookPluginPath(ctx context.Context, name string) string {
+	for _, dir := range filepath.SplitList(ExtractEnv(ctx, "PATH")) {
+		if dir == "" {
+			continue
+		}
+		if path, err := exec.LookPath(filepath.Join(dir, name)); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// runPlugin runs a plugin with the config and the profile used by the CLI, and returns its exit code.
+func runPlugin(ctx context.Context, path string, args []string) (int, error) {
+	ExtractLogger(ctx).Debugf("running plugin: %s %s\n", path, strings.Join(args, " "))
+
+	cmd := exec.Command(path, args...) //nolint:gosec
+	cmd.Env = append(os.Environ(),
+		scw.ScwConfigPathEnv+"="+ExtractConfigPath(ctx),
+		scw.ScwActiveProfileEnv+"="+ExtractProfileName(ctx),
+		cliConfig.ScwConfigPathEnv+"="+ExtractCliConfigPath(ctx),
+	)
+	return ExecCmd(ctx, cmd)
+}
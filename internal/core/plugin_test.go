@@ -0,0 +1,82 @@
+package core_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+func testPluginCommands() *core.Commands {
+	return core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "flower",
+			Verb:                 "create",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return &core.SuccessResult{}, nil
+			},
+		},
+	)
+}
+
+// testPluginPath returns a PATH holding an executable plugin for scw foo.
+func testPluginPath(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "scw-foo"), []byte("#!/bin/sh\n"), 0700)
+	assert.NoError(t, err)
+	return dir
+}
+
+func Test_Plugin(t *testing.T) {
+	t.Run("Run plugin", func(t *testing.T) {
+		pluginPath := testPluginPath(t)
+		core.Test(&core.TestConfig{
+			Commands: testPluginCommands(),
+			Cmd:      "scw -p test foo bar --output json",
+			OverrideEnv: map[string]string{
+				"PATH": pluginPath,
+			},
+			OverrideExec: func(_ *core.ExecFuncCtx, cmd *exec.Cmd) (int, error) {
+				assert.Equal(t, []string{filepath.Join(pluginPath, "scw-foo"), "bar", "--output", "json"}, cmd.Args)
+				assert.Contains(t, cmd.Env, "SCW_PROFILE=test")
+				return 3, nil
+			},
+			Check: core.TestCheckExitCode(3),
+		})(t)
+	})
+
+	t.Run("Known command", func(t *testing.T) {
+		pluginPath := testPluginPath(t)
+		assert.NoError(t, os.Rename(filepath.Join(pluginPath, "scw-foo"), filepath.Join(pluginPath, "scw-test")))
+		core.Test(&core.TestConfig{
+			Commands: testPluginCommands(),
+			Cmd:      "scw test flower create",
+			OverrideEnv: map[string]string{
+				"PATH": pluginPath,
+			},
+			OverrideExec: func(_ *core.ExecFuncCtx, _ *exec.Cmd) (int, error) {
+				t.Error("the plugin must not run")
+				return 0, nil
+			},
+			Check: core.TestCheckExitCode(0),
+		})(t)
+	})
+
+	t.Run("Unknown command", core.Test(&core.TestConfig{
+		Commands: testPluginCommands(),
+		Cmd:      "scw foo",
+		OverrideEnv: map[string]string{
+			"PATH": "",
+		},
+		Check: core.TestCheckExitCode(1),
+	}))
+}
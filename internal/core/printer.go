@@ -1,13 +1,16 @@
 package core
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
 	"strings"
 	"text/template"
+	"time"
 
+	"github.com/jmespath/go-jmespath"
 	"gopkg.in/yaml.v3"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/gofields"
@@ -37,14 +40,30 @@ const (
 	// PrinterTypeTemplate defines a go template to use to format output.
 	PrinterTypeTemplate = PrinterType("template")
 
+	// PrinterTypeCSV defines a comma-separated values formatter for lists.
+	PrinterTypeCSV = PrinterType("csv")
+
+	// PrinterTypeTSV defines a tab-separated values formatter for lists.
+	PrinterTypeTSV = PrinterType("tsv")
+
+	// PrinterTypeIDs defines a formatter printing only the identifiers of resources, one per line.
+	PrinterTypeIDs = PrinterType("ids")
+
+	// defaultIDField is the field holding the identifier of a resource when the command does not declare one.
+	defaultIDField = "ID"
+
 	// Option to enable pretty output on json printer.
 	PrinterOptJSONPretty = "pretty"
 )
 
 type PrinterConfig struct {
 	OutputFlag string
-	Stdout     io.Writer
-	Stderr     io.Writer
+	// QueryFlag is an optional JMESPath expression applied to results before printing them
+	QueryFlag string
+	// EnablePager pipes long human output through a pager when stdout is a terminal
+	EnablePager bool
+	Stdout      io.Writer
+	Stderr      io.Writer
 }
 
 // NewPrinter returns an initialized formatter corresponding to a given FormatterType.
@@ -52,6 +71,7 @@ func NewPrinter(config *PrinterConfig) (*Printer, error) {
 	printer := &Printer{
 		stdout: config.Stdout,
 		stderr: config.Stderr,
+		pager:  config.EnablePager,
 	}
 
 	// First we parse OutputFlag to extract printerName and printerOpt (e.g json=pretty)
@@ -80,11 +100,27 @@ func NewPrinter(config *PrinterConfig) (*Printer, error) {
 		if err != nil {
 			return nil, err
 		}
+	case PrinterTypeCSV.String():
+		setupHumanPrinter(printer, printerOpt)
+		printer.printerType = PrinterTypeCSV
+	case PrinterTypeTSV.String():
+		setupHumanPrinter(printer, printerOpt)
+		printer.printerType = PrinterTypeTSV
+	case PrinterTypeIDs.String():
+		printer.printerType = PrinterTypeIDs
 
 	default:
 		return nil, fmt.Errorf("invalid output format: %s", printerName)
 	}
 
+	if config.QueryFlag != "" {
+		query, err := jmespath.Compile(config.QueryFlag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query %s: %w", config.QueryFlag, err)
+		}
+		printer.query = query
+	}
+
 	return printer, nil
 }
 
@@ -110,7 +146,7 @@ func setupTemplatePrinter(printer *Printer, opts string) error {
 		}
 	}
 
-	t, err := template.New("OutputFormat").Parse(opts)
+	t, err := template.New("OutputFormat").Funcs(templateFuncMap).Parse(opts)
 	if err != nil {
 		return err
 	}
@@ -119,6 +155,29 @@ func setupTemplatePrinter(printer *Printer, opts string) error {
 	return nil
 }
 
+// templateFuncMap holds the helpers available in template outputs
+var templateFuncMap = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"join":  strings.Join,
+	"date":  templateDate,
+}
+
+// templateDate formats a time.Time or a *time.Time with layout, a nil time is rendered as an empty string
+func templateDate(layout string, value interface{}) (string, error) {
+	switch t := value.(type) {
+	case time.Time:
+		return t.Format(layout), nil
+	case *time.Time:
+		if t == nil {
+			return "", nil
+		}
+		return t.Format(layout), nil
+	default:
+		return "", fmt.Errorf("date expects a time, got %T", value)
+	}
+}
+
 func setupHumanPrinter(printer *Printer, opts string) {
 	printer.printerType = PrinterTypeHuman
 	if opts != "" {
@@ -142,8 +201,14 @@ type Printer struct {
 	// go template to use on template output
 	template *template.Template
 
-	// Allow to select specifics column in a table with human printer
+	// Allow to select specifics column in a table with human, csv and tsv printers
 	humanFields []string
+
+	// JMESPath query applied to the JSON representation of results
+	query *jmespath.JMESPath
+
+	// Show long human output through a pager
+	pager bool
 }
 
 func (p *Printer) Print(data interface{}, opt *human.MarshalOpt) error {
@@ -153,6 +218,18 @@ func (p *Printer) Print(data interface{}, opt *human.MarshalOpt) error {
 		return err
 	}
 
+	_, isError := data.(error)
+	if p.query != nil && !isError {
+		queried, err := p.queryResult(data)
+		if err != nil {
+			return p.printHuman(err, nil)
+		}
+		if p.printerType == PrinterTypeHuman || p.printerType == PrinterTypeWide {
+			return p.printQueriedHuman(queried)
+		}
+		data = queried
+	}
+
 	var err error
 	switch p.printerType {
 	case PrinterTypeHuman:
@@ -165,6 +242,12 @@ func (p *Printer) Print(data interface{}, opt *human.MarshalOpt) error {
 		err = p.printYAML(data)
 	case PrinterTypeTemplate:
 		err = p.printTemplate(data)
+	case PrinterTypeCSV:
+		err = p.printSeparatedValues(data, opt, ',')
+	case PrinterTypeTSV:
+		err = p.printSeparatedValues(data, opt, '\t')
+	case PrinterTypeIDs:
+		err = p.printIDs(data, opt)
 	default:
 		err = fmt.Errorf("unknown format: %s", p.printerType)
 	}
@@ -177,6 +260,60 @@ func (p *Printer) Print(data interface{}, opt *human.MarshalOpt) error {
 	return nil
 }
 
+// queryResult applies the query of the printer to the JSON representation of data.
+func (p *Printer) queryResult(data interface{}) (interface{}, error) {
+	rawJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	err = json.Unmarshal(rawJSON, &value)
+	if err != nil {
+		return nil, err
+	}
+	// We handle special case to make sure that a nil slice is queried as `[]`
+	if value == nil && data != nil && reflect.TypeOf(data).Kind() == reflect.Slice {
+		value = []interface{}{}
+	}
+
+	result, err := p.query.Search(value)
+	if err != nil {
+		return nil, &CliError{
+			Err:  fmt.Errorf("cannot apply query: %w", err),
+			Hint: "Visit https://jmespath.org to learn more about the query syntax.",
+		}
+	}
+	return result, nil
+}
+
+// printQueriedHuman prints the result of a query in a human-readable way.
+// Scalars and lists of scalars are printed one value per line, other results are printed as YAML.
+func (p *Printer) printQueriedHuman(data interface{}) error {
+	values, isList := data.([]interface{})
+	if !isList {
+		values = []interface{}{data}
+	}
+
+	lines := []string(nil)
+	for _, value := range values {
+		switch value.(type) {
+		case nil:
+			continue
+		case map[string]interface{}, []interface{}:
+			return p.printYAML(data)
+		default:
+			lines = append(lines, fmt.Sprint(value))
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+	_, err := fmt.Fprintln(p.stdout, strings.Join(lines, "\n"))
+	return err
+}
+
 func (p *Printer) printHuman(data interface{}, opt *human.MarshalOpt) error {
 	_, isError := data.(error)
 
@@ -185,7 +322,8 @@ func (p *Printer) printHuman(data interface{}, opt *human.MarshalOpt) error {
 			opt = &human.MarshalOpt{}
 		}
 
-		if len(p.humanFields) > 0 && reflect.TypeOf(data).Kind() != reflect.Slice {
+		_, isPagedResult := data.(*PagedResult)
+		if len(p.humanFields) > 0 && reflect.TypeOf(data).Kind() != reflect.Slice && !isPagedResult {
 			return p.printHuman(fmt.Errorf("list of fields for human output is only supported for commands that return a list"), nil)
 		}
 
@@ -219,9 +357,13 @@ func (p *Printer) printHuman(data interface{}, opt *human.MarshalOpt) error {
 
 	if isError {
 		_, err = fmt.Fprintln(p.stderr, str)
-	} else {
-		_, err = fmt.Fprintln(p.stdout, str)
+		return err
 	}
+
+	if p.usePager(str + "\n") {
+		return p.printWithPager(str + "\n")
+	}
+	_, err = fmt.Fprintln(p.stdout, str)
 	return err
 }
 
@@ -236,14 +378,124 @@ func (p *Printer) printWide(data interface{}, opt *human.MarshalOpt) error {
 	return p.printHuman(data, opt)
 }
 
+// printSeparatedValues prints a list as rows of values separated by separator, preceded by a header row.
+// Columns are the same as the ones of the human output.
+func (p *Printer) printSeparatedValues(data interface{}, opt *human.MarshalOpt, separator rune) error {
+	if _, isError := data.(error); isError {
+		return p.printHuman(data, nil)
+	}
+
+	if pagedResult, isPagedResult := data.(*PagedResult); isPagedResult {
+		data = pagedResult.Items
+	}
+
+	if data == nil || reflect.TypeOf(data).Kind() != reflect.Slice {
+		return p.printHuman(fmt.Errorf("output format %s is only supported for commands that return a list", p.printerType), nil)
+	}
+
+	if opt == nil {
+		opt = &human.MarshalOpt{}
+	}
+	if len(p.humanFields) > 0 {
+		opt.Fields = []*human.MarshalFieldOpt(nil)
+		for _, field := range p.humanFields {
+			opt.Fields = append(opt.Fields, &human.MarshalFieldOpt{
+				FieldName: field,
+			})
+		}
+	}
+
+	grid, err := human.MarshalGrid(data, opt)
+	switch e := err.(type) {
+	case *human.UnknownFieldError:
+		return p.printHuman(&CliError{
+			Err:  fmt.Errorf("unknown field '%s' in output options", e.FieldName),
+			Hint: fmt.Sprintf("Valid fields are: %s", strings.Join(e.ValidFields, ", ")),
+		}, nil)
+	case nil:
+		// Do nothing
+	default:
+		return p.printHuman(err, nil)
+	}
+
+	writer := csv.NewWriter(p.stdout)
+	writer.Comma = separator
+	err = writer.WriteAll(grid)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// printIDs prints the identifier of each resource of a list, or of a single resource, one per line.
+func (p *Printer) printIDs(data interface{}, opt *human.MarshalOpt) error {
+	if _, isError := data.(error); isError {
+		return p.printHuman(data, nil)
+	}
+
+	if pagedResult, isPagedResult := data.(*PagedResult); isPagedResult {
+		data = pagedResult.Items
+	}
+
+	idField := defaultIDField
+	if opt != nil && opt.IDField != "" {
+		idField = opt.IDField
+	}
+
+	items := []interface{}{data}
+	if data != nil && reflect.TypeOf(data).Kind() == reflect.Slice {
+		dataValue := reflect.ValueOf(data)
+		items = make([]interface{}, 0, dataValue.Len())
+		for i := 0; i < dataValue.Len(); i++ {
+			items = append(items, dataValue.Index(i).Interface())
+		}
+	}
+
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		id, err := gofields.GetValue(item, idField)
+		if _, isNil := err.(*gofields.NilValueError); isNil {
+			continue
+		}
+		if err != nil {
+			return p.printHuman(fmt.Errorf("output format ids is not supported for this command: result has no %s field", idField), nil)
+		}
+		idValue := reflect.ValueOf(id)
+		for idValue.Kind() == reflect.Ptr && !idValue.IsNil() {
+			idValue = idValue.Elem()
+		}
+		if idValue.Kind() == reflect.Ptr {
+			continue
+		}
+		_, err = fmt.Fprintln(p.stdout, idValue.Interface())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// errorResult is the structured form of an error that does not marshal itself, printed on stderr in json and yaml outputs.
+type errorResult struct {
+	Error string `json:"error" yaml:"error"`
+	Code  int    `json:"code" yaml:"code"`
+}
+
+func newErrorResult(err error) *errorResult {
+	return &errorResult{
+		Error: err.Error(),
+		Code:  ExitCode(err),
+	}
+}
+
 func (p *Printer) printJSON(data interface{}) error {
 	_, implementMarshaler := data.(json.Marshaler)
 	err, isError := data.(error)
 
 	if isError && !implementMarshaler {
-		data = map[string]string{
-			"error": err.Error(),
-		}
+		data = newErrorResult(err)
 	}
 
 	writer := p.stdout
@@ -256,7 +508,7 @@ func (p *Printer) printJSON(data interface{}) error {
 	}
 
 	// We handle special case to make sure that a nil slice is marshal as `[]`
-	if reflect.TypeOf(data).Kind() == reflect.Slice && reflect.ValueOf(data).IsNil() {
+	if data != nil && reflect.TypeOf(data).Kind() == reflect.Slice && reflect.ValueOf(data).IsNil() {
 		_, err := p.stdout.Write([]byte("[]\n"))
 		return err
 	}
@@ -269,9 +521,7 @@ func (p *Printer) printYAML(data interface{}) error {
 	err, isError := data.(error)
 
 	if isError && !implementMarshaler {
-		data = map[string]string{
-			"error": err.Error(),
-		}
+		data = newErrorResult(err)
 	}
 
 	writer := p.stdout
@@ -280,7 +530,43 @@ func (p *Printer) printYAML(data interface{}) error {
 	}
 	encoder := yaml.NewEncoder(writer)
 
-	return encoder.Encode(data)
+	if implementMarshaler {
+		return encoder.Encode(data)
+	}
+
+	// Data goes through its JSON representation so that field names, custom marshalers,
+	// times and pointers are rendered the same way as with the json output
+	node, err := jsonToYAMLNode(data)
+	if err != nil {
+		return err
+	}
+
+	return encoder.Encode(node)
+}
+
+// jsonToYAMLNode returns the YAML document of the JSON representation of data, keeping the fields order
+func jsonToYAMLNode(data interface{}) (*yaml.Node, error) {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &yaml.Node{}
+	err = yaml.Unmarshal(jsonData, node)
+	if err != nil {
+		return nil, err
+	}
+	resetYAMLNodeStyle(node)
+
+	return node, nil
+}
+
+// resetYAMLNodeStyle drops the JSON flow and quoting styles so that nodes are rendered as block YAML
+func resetYAMLNodeStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLNodeStyle(child)
+	}
 }
 
 func (p *Printer) printTemplate(data interface{}) error {
@@ -2,10 +2,14 @@ package core_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/alecthomas/assert"
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 func Test_CorePrinter(t *testing.T) {
@@ -66,6 +70,12 @@ func Test_CorePrinter(t *testing.T) {
 		Cmd:      "scw -D list -o human=Name,ID,Unknown",
 		Check:    core.TestCheckGolden(),
 	}))
+
+	t.Run("human-list-no-pager", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw list --no-pager",
+		Check:    core.TestCheckGolden(),
+	}))
 }
 
 func Test_YamlPrinter(t *testing.T) {
@@ -74,6 +84,14 @@ func Test_YamlPrinter(t *testing.T) {
 		Name string `json:"name"`
 	}
 
+	type Account struct {
+		OrganizationID string     `json:"organization_id"`
+		CreatedAt      *time.Time `json:"created_at"`
+		DeletedAt      *time.Time `json:"deleted_at"`
+		Owner          *Human     `json:"owner"`
+		Zone           scw.Zone   `json:"zone"`
+	}
+
 	commands := core.NewCommands(
 		&core.Command{
 			Namespace: "get",
@@ -95,6 +113,19 @@ func Test_YamlPrinter(t *testing.T) {
 				}, nil
 			},
 		},
+		&core.Command{
+			Namespace: "nested",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				createdAt := time.Date(2023, 4, 24, 14, 38, 56, 0, time.UTC)
+				return &Account{
+					OrganizationID: "11111111-1111-1111-1111-111111111111",
+					CreatedAt:      &createdAt,
+					Owner:          &Human{ID: "111111111-111111111", Name: "David Copperfield"},
+					Zone:           scw.ZoneFrPar1,
+				}, nil
+			},
+		},
 		&core.Command{
 			Namespace: "NilSlice",
 			ArgsType:  reflect.TypeOf(struct{}{}),
@@ -139,6 +170,12 @@ func Test_YamlPrinter(t *testing.T) {
 		Cmd:      "scw NilSlice -o yaml",
 		Check:    core.TestCheckGolden(),
 	}))
+
+	t.Run("nested-consistent-with-json", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw nested -o yaml",
+		Check:    core.TestCheckGolden(),
+	}))
 }
 
 func Test_TemplatePrinter(t *testing.T) {
@@ -147,6 +184,12 @@ func Test_TemplatePrinter(t *testing.T) {
 		Name string `json:"name"`
 	}
 
+	type TaggedHuman struct {
+		Name         string     `json:"name"`
+		Tags         []string   `json:"tags"`
+		CreationDate *time.Time `json:"creation_date"`
+	}
+
 	commands := core.NewCommands(
 		&core.Command{
 			Namespace: "get",
@@ -168,6 +211,17 @@ func Test_TemplatePrinter(t *testing.T) {
 				}, nil
 			},
 		},
+		&core.Command{
+			Namespace: "tagged",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				creationDate := time.Date(2023, 4, 24, 14, 38, 56, 0, time.UTC)
+				return []*TaggedHuman{
+					{Name: "David Copperfield", Tags: []string{"magic", "illusion"}, CreationDate: &creationDate},
+					{Name: "Xavier Niel"},
+				}, nil
+			},
+		},
 		&core.Command{
 			Namespace: "NilSlice",
 			ArgsType:  reflect.TypeOf(struct{}{}),
@@ -227,4 +281,261 @@ func Test_TemplatePrinter(t *testing.T) {
 		},
 		Check: core.TestCheckGolden(),
 	}))
+
+	t.Run("template-functions", core.Test(&core.TestConfig{
+		Commands: commands,
+		Args: []string{
+			"scw", "tagged", "-o",
+			// Args are rendered as a template by the test runner so the output template must be escaped
+			`template={{ "{{" }} upper .Name }}|{{ "{{" }} lower .Name }}|{{ "{{" }} join .Tags "," }}|{{ "{{" }} date "2006-01-02" .CreationDate }}`,
+		},
+		Check: core.TestCheckGolden(),
+	}))
+}
+
+func Test_SeparatedValuesPrinter(t *testing.T) {
+	type Human struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	commands := core.NewCommands(
+		&core.Command{
+			Namespace: "get",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return Human{
+					ID:   "111111111-111111111",
+					Name: "David Copperfield",
+				}, nil
+			},
+		},
+		&core.Command{
+			Namespace: "list",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return []*Human{
+					{ID: "111111111-111111111", Name: "David Copperfield"},
+					{ID: "222222222-222222222", Name: "Niel, \"Xavier\""},
+				}, nil
+			},
+		},
+	)
+
+	t.Run("csv-list-without-option", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw list -o csv",
+		Check:    core.TestCheckGolden(),
+	}))
+
+	t.Run("csv-list-with-options", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw list -o csv=Name,ID",
+		Check:    core.TestCheckGolden(),
+	}))
+
+	t.Run("csv-list-with-options-unknown-column", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw list -o csv=Name,Unknown",
+		Check:    core.TestCheckGolden(),
+	}))
+
+	t.Run("csv-simple", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw get -o csv",
+		Check:    core.TestCheckGolden(),
+	}))
+
+	t.Run("tsv-list-without-option", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw list -o tsv",
+		Check:    core.TestCheckGolden(),
+	}))
+}
+
+func Test_QueryPrinter(t *testing.T) {
+	type Human struct {
+		ID   string   `json:"id"`
+		Name string   `json:"name"`
+		Tags []string `json:"tags"`
+	}
+
+	commands := core.NewCommands(
+		&core.Command{
+			Namespace: "get",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return Human{
+					ID:   "111111111-111111111",
+					Name: "David Copperfield",
+					Tags: []string{"magic", "illusion"},
+				}, nil
+			},
+		},
+		&core.Command{
+			Namespace: "list",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return []*Human{
+					{ID: "111111111-111111111", Name: "David Copperfield", Tags: []string{"magic"}},
+					{ID: "222222222-222222222", Name: "Xavier Niel"},
+				}, nil
+			},
+		},
+	)
+
+	t.Run("human-scalar", core.Test(&core.TestConfig{
+		Commands: commands,
+		Args:     []string{"scw", "get", "--query", "name"},
+		Check:    core.TestCheckGolden(),
+	}))
+
+	t.Run("human-list-of-scalars", core.Test(&core.TestConfig{
+		Commands: commands,
+		Args:     []string{"scw", "list", "--query", "[].id"},
+		Check:    core.TestCheckGolden(),
+	}))
+
+	t.Run("human-filter-projection", core.Test(&core.TestConfig{
+		Commands: commands,
+		Args:     []string{"scw", "list", "--query", "[?contains(tags || `[]`, 'magic')].{id: id, name: name}"},
+		Check:    core.TestCheckGolden(),
+	}))
+
+	t.Run("json", core.Test(&core.TestConfig{
+		Commands: commands,
+		Args:     []string{"scw", "list", "-o", "json", "--query", "[1].name"},
+		Check:    core.TestCheckGolden(),
+	}))
+
+	t.Run("template", core.Test(&core.TestConfig{
+		Commands: commands,
+		// We escape this sequence because there is already golang template rendering on commands in core.Test
+		Args:  []string{"scw", "list", "-o", "{{`template={{ .name }}`}}", "--query", "[?name=='Xavier Niel']"},
+		Check: core.TestCheckGolden(),
+	}))
+
+	t.Run("invalid-query", core.Test(&core.TestConfig{
+		Commands: commands,
+		Args:     []string{"scw", "list", "--query", "[?name=="},
+		Check:    core.TestCheckExitCode(1),
+	}))
+}
+
+func Test_IDsPrinter(t *testing.T) {
+	type Human struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	type Version struct {
+		Name string `json:"name"`
+	}
+
+	commands := core.NewCommands(
+		&core.Command{
+			Namespace: "get",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return &Human{
+					ID:   "111111111-111111111",
+					Name: "David Copperfield",
+				}, nil
+			},
+		},
+		&core.Command{
+			Namespace: "list",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return []*Human{
+					{ID: "111111111-111111111", Name: "David Copperfield"},
+					{ID: "222222222-222222222", Name: "Xavier Niel"},
+				}, nil
+			},
+		},
+		&core.Command{
+			Namespace: "version",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			View:      &core.View{IDField: "Name"},
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return []Version{{Name: "1.27.1"}, {Name: "1.26.4"}}, nil
+			},
+		},
+		&core.Command{
+			Namespace: "unsupported",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return []Version{{Name: "1.27.1"}}, nil
+			},
+		},
+	)
+
+	t.Run("simple", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw get -o ids",
+		Check:    core.TestCheckGolden(),
+	}))
+
+	t.Run("list", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw list -o ids",
+		Check:    core.TestCheckGolden(),
+	}))
+
+	t.Run("custom-id-field", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw version -o ids",
+		Check:    core.TestCheckGolden(),
+	}))
+
+	t.Run("unsupported", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw unsupported -o ids",
+		Check:    core.TestCheckGolden(),
+	}))
+}
+
+func Test_JSONErrors(t *testing.T) {
+	commands := core.NewCommands(
+		&core.Command{
+			Namespace: "locked",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, &core.CliError{
+					Err:  errors.New("item is locked"),
+					Hint: "Unlock the item first",
+					Code: 3,
+				}
+			},
+		},
+		&core.Command{
+			Namespace: "broken",
+			ArgsType:  reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, errors.New("item is broken")
+			},
+		},
+	)
+
+	t.Run("cli-error", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw locked -o json",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(3),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, `{"message":"item is locked","error":{},"hint":"Unlock the item first","code":3}`+"\n", string(ctx.Stderr))
+			},
+		),
+	}))
+
+	t.Run("error", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw broken -o json",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, `{"error":"item is broken","code":1}`+"\n", string(ctx.Stderr))
+			},
+		),
+	}))
 }
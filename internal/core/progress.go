@@ -0,0 +1,164 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ProgressEventType is the kind of a ProgressEvent.
+type ProgressEventType string
+
+const (
+	ProgressEventWaitStarted   = ProgressEventType("wait_started")
+	ProgressEventStatusChanged = ProgressEventType("status_changed")
+	ProgressEventWaitSucceeded = ProgressEventType("wait_succeeded")
+	ProgressEventWaitFailed    = ProgressEventType("wait_failed")
+)
+
+// ProgressEvent describes a step of a long-running operation such as a wait.
+type ProgressEvent struct {
+	Time     time.Time         `json:"time"`
+	Event    ProgressEventType `json:"event"`
+	Resource string            `json:"resource,omitempty"`
+	Status   string            `json:"status,omitempty"`
+	Error    string            `json:"error,omitempty"`
+}
+
+// progressBus dispatches progress events to its subscribers.
+type progressBus struct {
+	mu          sync.Mutex
+	subscribers []func(event *ProgressEvent)
+}
+
+func (b *progressBus) subscribe(fn func(event *ProgressEvent)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers = append(b.subscribers, fn)
+}
+
+func (b *progressBus) hasSubscribers() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers) > 0
+}
+
+func (b *progressBus) publish(event *ProgressEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	for _, fn := range b.subscribers {
+		fn(event)
+	}
+}
+
+// PublishProgressEvent sends a progress event to the subscribers of the current command, if any.
+func PublishProgressEvent(ctx context.Context, event *ProgressEvent) {
+	bus := extractMeta(ctx).progressBus
+	if bus != nil {
+		bus.publish(event)
+	}
+}
+
+// jsonLinesProgressWriter returns a subscriber writing each event as a JSON object on its own line.
+func jsonLinesProgressWriter(w io.Writer) func(event *ProgressEvent) {
+	encoder := json.NewEncoder(w)
+	return func(event *ProgressEvent) {
+		_ = encoder.Encode(event)
+	}
+}
+
+// runWaitWithProgress runs waitFunc and publishes its start, the status changes of the waited resource and its end.
+// Status changes are detected by looking at the responses of the GET requests made during the wait.
+func runWaitWithProgress(ctx context.Context, cmd *Command, waitFunc func() (interface{}, error)) (interface{}, error) {
+	meta := extractMeta(ctx)
+	bus := meta.progressBus
+	if bus == nil || !bus.hasSubscribers() {
+		return waitFunc()
+	}
+
+	bus.publish(&ProgressEvent{Event: ProgressEventWaitStarted, Resource: cmd.Resource})
+
+	if meta.httpClient != nil {
+		transport := meta.httpClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		meta.httpClient.Transport = &progressTransport{
+			transport: transport,
+			resource:  cmd.Resource,
+			bus:       bus,
+		}
+		defer func() {
+			meta.httpClient.Transport = transport
+		}()
+	}
+
+	result, err := waitFunc()
+	if err != nil {
+		bus.publish(&ProgressEvent{Event: ProgressEventWaitFailed, Resource: cmd.Resource, Error: err.Error()})
+		return nil, err
+	}
+	bus.publish(&ProgressEvent{Event: ProgressEventWaitSucceeded, Resource: cmd.Resource})
+	return result, nil
+}
+
+// progressTransport publishes a status_changed event each time the status of a polled resource changes.
+type progressTransport struct {
+	transport  http.RoundTripper
+	resource   string
+	bus        *progressBus
+	lastStatus string
+}
+
+func (p *progressTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	res, err := p.transport.RoundTrip(request)
+	if err != nil || request.Method != http.MethodGet || res.StatusCode != http.StatusOK || res.Body == nil {
+		return res, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	status := resourceStatus(body)
+	if status != "" && status != p.lastStatus {
+		p.lastStatus = status
+		p.bus.publish(&ProgressEvent{Event: ProgressEventStatusChanged, Resource: p.resource, Status: status})
+	}
+	return res, nil
+}
+
+// resourceStatus extracts the status of a resource from an API response.
+// The resource may be wrapped in an object with a single key, e.g. {"server": {"state": "running"}}.
+func resourceStatus(body []byte) string {
+	resource := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &resource); err != nil {
+		return ""
+	}
+	if len(resource) == 1 {
+		for _, value := range resource {
+			wrapped := map[string]json.RawMessage{}
+			if err := json.Unmarshal(value, &wrapped); err == nil {
+				resource = wrapped
+			}
+		}
+	}
+
+	for _, key := range []string{"status", "state"} {
+		status := ""
+		if err := json.Unmarshal(resource[key], &status); err == nil && status != "" {
+			return status
+		}
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+type testProgressItem struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// testProgressCommands returns a create command whose wait reports each status of the item.
+func testProgressCommands(waitErr error) *core.Commands {
+	return core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "create",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (i interface{}, e error) {
+				return &testProgressItem{Name: "a", Status: "creating"}, nil
+			},
+			WaitFunc: func(ctx context.Context, _, respI interface{}) (interface{}, error) {
+				item := respI.(*testProgressItem)
+				for _, status := range []string{"provisioning", "ready"} {
+					item.Status = status
+					core.PublishProgressEvent(ctx, &core.ProgressEvent{
+						Event:    core.ProgressEventStatusChanged,
+						Resource: "item",
+						Status:   status,
+					})
+				}
+				if waitErr != nil {
+					return nil, waitErr
+				}
+				return item, nil
+			},
+		},
+	)
+}
+
+var progressTimeReplacement = core.GoldenReplacement{
+	Pattern:     regexp.MustCompile(`"time":"[^"]+"`),
+	Replacement: `"time":"2006-01-02T15:04:05Z"`,
+}
+
+func Test_ProgressEvents(t *testing.T) {
+	t.Run("JSON lines on stderr", core.Test(&core.TestConfig{
+		Commands: testProgressCommands(nil),
+		Cmd:      "scw test item create --wait -o json",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGoldenAndReplacePatterns(progressTimeReplacement),
+		),
+	}))
+
+	t.Run("Wait failure", core.Test(&core.TestConfig{
+		Commands: testProgressCommands(errors.New("item is in error")),
+		Cmd:      "scw test item create --wait -o json",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGoldenAndReplacePatterns(progressTimeReplacement),
+		),
+	}))
+
+	t.Run("No events with human output", core.Test(&core.TestConfig{
+		Commands: testProgressCommands(nil),
+		Cmd:      "scw test item create --wait",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+	}))
+}
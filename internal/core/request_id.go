@@ -0,0 +1,79 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// requestIDHeader is the header holding the ID the API gives to each request, support uses it to find the request.
+const requestIDHeader = "X-Request-Id"
+
+// failedResponse is the metadata of the last API response with an error status.
+type failedResponse struct {
+	StatusCode int
+	RequestID  string
+}
+
+// failedResponseRecorder keeps the metadata of the last failed API response of the running command.
+type failedResponseRecorder struct {
+	mu   sync.Mutex
+	last *failedResponse
+}
+
+func (r *failedResponseRecorder) record(res *http.Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.last = &failedResponse{
+		StatusCode: res.StatusCode,
+		RequestID:  res.Header.Get(requestIDHeader),
+	}
+}
+
+func (r *failedResponseRecorder) pop() *failedResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	last := r.last
+	r.last = nil
+	return last
+}
+
+// failedResponseTransport records the metadata of API responses with an error status.
+type failedResponseTransport struct {
+	transport http.RoundTripper
+	recorder  *failedResponseRecorder
+}
+
+func (f *failedResponseTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	res, err := f.transport.RoundTrip(request)
+	if err == nil && res.StatusCode >= http.StatusBadRequest {
+		f.recorder.record(res)
+	}
+	return res, err
+}
+
+// failedResponseInterceptor adds the request ID and the HTTP status of the failed API response to the CLI errors built from SDK errors.
+func failedResponseInterceptor(ctx context.Context, args interface{}, runner CommandRunner) (interface{}, error) {
+	recorder := extractMeta(ctx).failedResponses
+	if recorder == nil {
+		return runner(ctx, args)
+	}
+	_ = recorder.pop()
+
+	res, err := runner(ctx, args)
+	cliErr, isCliErr := err.(*CliError)
+	if !isCliErr {
+		return res, err
+	}
+	if _, isSdkErr := cliErr.Err.(scw.SdkError); !isSdkErr {
+		return res, err
+	}
+
+	if failedResponse := recorder.pop(); failedResponse != nil {
+		cliErr.RequestID = failedResponse.RequestID
+		cliErr.StatusCode = failedResponse.StatusCode
+	}
+	return res, err
+}
@@ -0,0 +1,69 @@
+package core_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func Test_RequestIDInErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "22222222-2222-2222-2222-222222222222")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"message":"item is locked"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	commands := core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "get",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+				client, err := scw.NewClient(
+					scw.WithAPIURL(server.URL),
+					scw.WithHTTPClient(core.ExtractHTTPClient(ctx)),
+				)
+				if err != nil {
+					return nil, err
+				}
+				err = client.Do(&scw.ScalewayRequest{Method: http.MethodGet, Path: "/items/1"}, nil, scw.WithContext(ctx))
+				return nil, err
+			},
+		},
+	)
+
+	t.Run("Human", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw test item get",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stderr), "Item is locked")
+				assert.Contains(t, string(ctx.Stderr), "Request ID:\n22222222-2222-2222-2222-222222222222 (HTTP 409)")
+			},
+		),
+	}))
+
+	t.Run("JSON", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw test item get -o json",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stderr), `"request_id"`)
+				assert.Contains(t, string(ctx.Stderr), "22222222-2222-2222-2222-222222222222")
+				assert.Contains(t, string(ctx.Stderr), `"status_code"`)
+			},
+		),
+	}))
+}
@@ -0,0 +1,183 @@
+package core
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/gofields"
+	"github.com/scaleway/scaleway-sdk-go/strcase"
+)
+
+// CommandsSchema is a machine-readable description of the commands of the CLI.
+// It is printed by `scw --dump-commands-schema` for third-party tools such as IDE plugins or completion engines.
+type CommandsSchema struct {
+	Namespaces []*NamespaceSchema `json:"namespaces"`
+}
+
+type NamespaceSchema struct {
+	Name     string           `json:"name"`
+	Short    string           `json:"short,omitempty"`
+	Long     string           `json:"long,omitempty"`
+	Commands []*CommandSchema `json:"commands"`
+}
+
+type CommandSchema struct {
+	Namespace  string           `json:"namespace"`
+	Resource   string           `json:"resource,omitempty"`
+	Verb       string           `json:"verb,omitempty"`
+	Short      string           `json:"short,omitempty"`
+	Long       string           `json:"long,omitempty"`
+	Deprecated bool             `json:"deprecated,omitempty"`
+	Aliases    []string         `json:"aliases,omitempty"`
+	Groups     []string         `json:"groups,omitempty"`
+	Args       []*ArgSchema     `json:"args,omitempty"`
+	Examples   []*ExampleSchema `json:"examples,omitempty"`
+}
+
+type ArgSchema struct {
+	Name       string `json:"name"`
+	Short      string `json:"short,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Required   bool   `json:"required,omitempty"`
+	Positional bool   `json:"positional,omitempty"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+	Default    string `json:"default,omitempty"`
+	// EnumValues are the static values of the argument, values listed from the API are not part of the schema
+	EnumValues []string `json:"enum_values,omitempty"`
+	OneOfGroup string   `json:"one_of_group,omitempty"`
+	// Validated is true when the value is checked by a custom validation before running the command
+	Validated   bool `json:"validated,omitempty"`
+	CanLoadFile bool `json:"can_load_file,omitempty"`
+}
+
+type ExampleSchema struct {
+	Short       string `json:"short,omitempty"`
+	CommandLine string `json:"command_line"`
+}
+
+// GetSchema returns the schema of the visible commands, grouped by namespace.
+func (c *Commands) GetSchema(binaryName string) *CommandsSchema {
+	schema := &CommandsSchema{
+		Namespaces: []*NamespaceSchema{},
+	}
+	namespaces := map[string]*NamespaceSchema{}
+
+	for _, cmd := range c.GetSortedCommand() {
+		if cmd.Hidden {
+			continue
+		}
+
+		namespace, exists := namespaces[cmd.Namespace]
+		if !exists {
+			namespace = &NamespaceSchema{
+				Name:     cmd.Namespace,
+				Commands: []*CommandSchema{},
+			}
+			namespaces[cmd.Namespace] = namespace
+			schema.Namespaces = append(schema.Namespaces, namespace)
+		}
+		if cmd.Resource == "" && cmd.Verb == "" {
+			namespace.Short = cmd.Short
+			namespace.Long = cmd.Long
+		}
+
+		namespace.Commands = append(namespace.Commands, cmd.getSchema(binaryName))
+	}
+
+	return schema
+}
+
+func (c *Command) getSchema(binaryName string) *CommandSchema {
+	cmdSchema := &CommandSchema{
+		Namespace:  c.Namespace,
+		Resource:   c.Resource,
+		Verb:       c.Verb,
+		Short:      c.Short,
+		Long:       c.Long,
+		Deprecated: c.Deprecated,
+		Aliases:    c.Aliases,
+		Groups:     c.Groups,
+	}
+
+	for _, argSpec := range c.ArgSpecs {
+		argSchema := &ArgSchema{
+			Name:        argSpec.Name,
+			Short:       argSpec.Short,
+			Type:        argSchemaType(c.ArgsType, argSpec.Name),
+			Required:    argSpec.Required || argSpec.Positional,
+			Positional:  argSpec.Positional,
+			Deprecated:  argSpec.Deprecated,
+			EnumValues:  argSpec.EnumValues,
+			OneOfGroup:  argSpec.OneOfGroup,
+			Validated:   argSpec.ValidateFunc != nil,
+			CanLoadFile: argSpec.CanLoadFile,
+		}
+		if argSpec.Default != nil {
+			argSchema.Default, _ = argSpec.Default(GetDocGenContext())
+		}
+		cmdSchema.Args = append(cmdSchema.Args, argSchema)
+	}
+
+	for _, example := range c.Examples {
+		cmdSchema.Examples = append(cmdSchema.Examples, &ExampleSchema{
+			Short:       example.Short,
+			CommandLine: example.GetCommandLine(binaryName, c),
+		})
+	}
+
+	return cmdSchema
+}
+
+// argSchemaType returns the type of the value of an argument as a JSON schema type when it can be found in the args type.
+// Times and durations are reported as such as they are parsed from a dedicated format.
+func argSchemaType(argsType reflect.Type, argName string) string {
+	if argsType == nil {
+		return ""
+	}
+
+	parts := strings.Split(argName, ".")
+	for i, part := range parts {
+		switch part {
+		case sliceSchema:
+			parts[i] = "0"
+		case mapSchema:
+			parts[i] = "key"
+		default:
+			parts[i] = strcase.ToPublicGoName(part)
+		}
+	}
+
+	t, err := gofields.GetType(argsType, strings.Join(parts, "."))
+	if err != nil {
+		return ""
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == reflect.TypeOf(time.Time{}):
+		return "time"
+	case t == reflect.TypeOf(time.Duration(0)):
+		return "duration"
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,105 @@
+package core_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+type testSchemaVolume struct {
+	Size uint64
+}
+
+type testSchemaCreateArgs struct {
+	Name    string
+	Type    string
+	Volumes []*testSchemaVolume
+	Tags    []string
+	Env     map[string]string
+	Timeout time.Duration
+	Start   *bool
+}
+
+func testSchemaCommands() *core.Commands {
+	return core.NewCommands(
+		&core.Command{
+			Namespace: "test",
+			Short:     "A test namespace",
+		},
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "server",
+			Verb:                 "create",
+			Short:                "Create a server",
+			Aliases:              []string{"new"},
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(testSchemaCreateArgs{}),
+			ArgSpecs: core.ArgSpecs{
+				{
+					Name:       "name",
+					Short:      "Name of the server",
+					Required:   true,
+					Positional: true,
+				},
+				{
+					Name:       "type",
+					Default:    core.DefaultValueSetter("DEV1-S"),
+					EnumValues: []string{"DEV1-S", "DEV1-M"},
+				},
+				{
+					Name: "volumes.{index}.size",
+					ValidateFunc: func(_ *core.ArgSpec, _ interface{}) error {
+						return nil
+					},
+				},
+				{
+					Name: "tags.{index}",
+				},
+				{
+					Name: "env.{key}",
+				},
+				{
+					Name: "timeout",
+				},
+				{
+					Name:       "start",
+					Deprecated: true,
+				},
+			},
+			Examples: []*core.Example{
+				{
+					Short:    "Create a server",
+					ArgsJSON: `{"name": "web"}`,
+				},
+			},
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, nil
+			},
+		},
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "secret",
+			Verb:                 "run",
+			Hidden:               true,
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, nil
+			},
+		},
+	)
+}
+
+func Test_DumpCommandsSchema(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands: testSchemaCommands(),
+		Cmd:      "scw --dump-commands-schema",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+	}))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/fatih/color"
 	"github.com/scaleway/scaleway-cli/v2/internal/cache"
 	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+	"github.com/scaleway/scaleway-cli/v2/internal/redact"
 	"github.com/scaleway/scaleway-cli/v2/internal/sentry"
 	"github.com/scaleway/scaleway-cli/v2/internal/terminal"
 	"github.com/spf13/cobra"
@@ -254,10 +256,61 @@ func NewShellCompleter(ctx context.Context) *Completer {
 	}
 }
 
+// shellHistoryMaxSize is the number of commands kept in the shell history file
+const shellHistoryMaxSize = 1000
+
+// shellHistoryPath returns the file keeping the commands entered in shell between sessions
+func shellHistoryPath(ctx context.Context) string {
+	return filepath.Join(ExtractCacheDir(ctx), "shell-history")
+}
+
+// loadShellHistory returns the commands entered in previous shell sessions, oldest first
+func loadShellHistory(ctx context.Context) []string {
+	content, err := os.ReadFile(shellHistoryPath(ctx))
+	if err != nil {
+		return nil
+	}
+	if strings.TrimSpace(string(content)) == "" {
+		return nil
+	}
+	history := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(history) > shellHistoryMaxSize {
+		history = history[len(history)-shellHistoryMaxSize:]
+	}
+	return history
+}
+
+// saveShellHistory adds a command to the shell history file, keeping only the last shellHistoryMaxSize commands.
+// Secret arguments are masked before being written. Failures are only logged
+// as the shell must work without a writable cache directory.
+func saveShellHistory(ctx context.Context, args []string) {
+	redactedArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		redactedArgs = append(redactedArgs, redact.Arg(arg))
+	}
+
+	history := append(loadShellHistory(ctx), strings.Join(redactedArgs, " "))
+	if len(history) > shellHistoryMaxSize {
+		history = history[len(history)-shellHistoryMaxSize:]
+	}
+
+	path := shellHistoryPath(ctx)
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err == nil {
+		err = os.WriteFile(path, []byte(strings.Join(history, "\n")+"\n"), 0600)
+	}
+	if err != nil {
+		ExtractLogger(ctx).Debugf("cannot save shell history: %s", err)
+	}
+}
+
 // shellExecutor returns the function that will execute command entered in shell
-func shellExecutor(rootCmd *cobra.Command, printer *Printer, meta *Meta) func(s string) {
+func shellExecutor(ctx context.Context, rootCmd *cobra.Command, printer *Printer, meta *Meta) func(s string) {
 	return func(s string) {
 		args := strings.Fields(s)
+		if len(args) > 0 {
+			saveShellHistory(ctx, args)
+		}
 
 		sentry.AddCommandContext(strings.Join(removeOptions(args), " "))
 
@@ -285,7 +338,7 @@ func shellExecutor(rootCmd *cobra.Command, printer *Printer, meta *Meta) func(s
 
 		autoCompleteCache.Update(meta.command.Namespace)
 
-		printErr := printer.Print(meta.result, meta.command.getHumanMarshalerOpt())
+		printErr := printer.Print(meta.result, humanMarshalerOpt(meta, meta.command))
 		if printErr != nil {
 			_, _ = fmt.Fprintln(os.Stderr, printErr)
 		}
@@ -320,13 +373,14 @@ func RunShell(ctx context.Context, printer *Printer, meta *Meta, rootCmd *cobra.
 	rootCmd.RemoveCommand(shellCobraCommand)
 	meta.Commands.Remove("shell", "")
 
-	executor := shellExecutor(rootCmd, printer, meta)
+	executor := shellExecutor(ctx, rootCmd, printer, meta)
 	quitMessage := terminal.Style("- Type Ctrl+d to quit.", color.Bold, color.FgCyan)
 	fmt.Println(quitMessage)
 	p := prompt.New(
 		executor,
 		completer.Complete,
 		prompt.OptionPrefix(">>> "),
+		prompt.OptionHistory(loadShellHistory(ctx)),
 		prompt.OptionSuggestionBGColor(prompt.Purple),
 		prompt.OptionSelectedSuggestionBGColor(prompt.Fuchsia),
 		prompt.OptionSelectedSuggestionTextColor(prompt.White),
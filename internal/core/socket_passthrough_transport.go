@@ -15,12 +15,18 @@ func init() {
 	}
 }
 
-type SocketPassthroughTransport struct{}
+type SocketPassthroughTransport struct {
+	// transport sends the requests not going to the docker socket, http.DefaultTransport is used when nil
+	transport http.RoundTripper
+}
 
 func (r *SocketPassthroughTransport) RoundTrip(request *http.Request) (*http.Response, error) {
 	if request.URL.Host == "/var/run/docker.sock" {
 		return socketTransport.RoundTrip(request)
 	}
 
+	if r.transport != nil {
+		return r.transport.RoundTrip(request)
+	}
 	return http.DefaultTransport.RoundTrip(request)
 }
@@ -0,0 +1,208 @@
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// sortKey is a field used to sort the items of a list, requested with --sort.
+type sortKey struct {
+	// Field is the path of the field in the JSON output of an item, e.g. public_ip.address.
+	Field      string
+	Descending bool
+}
+
+func addSortFlag(cobraCmd *cobra.Command) {
+	cobraCmd.PersistentFlags().String("sort", "", "sort the items by a field, prefixed with - for a descending order, e.g. -created_at, several fields can be separated by commas")
+}
+
+// getSortKeysFromFlags returns the fields requested with --sort.
+func getSortKeysFromFlags(cobraCmd *cobra.Command, cmd *Command) ([]*sortKey, error) {
+	if !commandIsList(cmd) {
+		return nil, nil
+	}
+
+	rawSort, err := cobraCmd.PersistentFlags().GetString("sort")
+	if err != nil || rawSort == "" {
+		return nil, err
+	}
+
+	keys := []*sortKey(nil)
+	for _, field := range strings.Split(rawSort, ",") {
+		key := &sortKey{}
+		key.Field, key.Descending = strings.CutPrefix(strings.TrimSpace(field), "-")
+		key.Field = strings.ReplaceAll(key.Field, "-", "_")
+		if key.Field == "" {
+			return nil, &CliError{
+				Err:  fmt.Errorf("invalid sort %s", rawSort),
+				Hint: "sort must be a list of fields separated by commas, e.g. --sort state,-created_at",
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// sortResult sorts the items of a list result, items with equal fields keep their order.
+func sortResult(result interface{}, keys []*sortKey) (interface{}, error) {
+	if pagedResult, isPaged := result.(*PagedResult); isPaged {
+		items, err := sortResult(pagedResult.Items, keys)
+		if err != nil {
+			return nil, err
+		}
+		pagedResult.Items = items
+		return pagedResult, nil
+	}
+
+	value := reflect.ValueOf(result)
+	if value.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("cannot sort a result of type %T, a slice is expected", result)
+	}
+
+	itemType := value.Type().Elem()
+	for itemType.Kind() == reflect.Ptr {
+		itemType = itemType.Elem()
+	}
+	for _, key := range keys {
+		if !hasJSONField(itemType, strings.Split(key.Field, ".")) {
+			return nil, &CliError{
+				Err:  fmt.Errorf("unknown field %s in sort", key.Field),
+				Hint: "available fields: " + strings.Join(jsonFieldNames(itemType), ", "),
+			}
+		}
+	}
+
+	sorted := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+	reflect.Copy(sorted, value)
+	sort.SliceStable(sorted.Interface(), func(i, j int) bool {
+		for _, key := range keys {
+			path := strings.Split(key.Field, ".")
+			a, b := jsonFieldValue(sorted.Index(i), path), jsonFieldValue(sorted.Index(j), path)
+			// Missing values are sorted last whatever the order
+			if !a.IsValid() || !b.IsValid() {
+				if a.IsValid() == b.IsValid() {
+					continue
+				}
+				return a.IsValid()
+			}
+
+			order := compareValues(a, b)
+			if order == 0 {
+				continue
+			}
+			if key.Descending {
+				return order > 0
+			}
+			return order < 0
+		}
+		return false
+	})
+
+	return sorted.Interface(), nil
+}
+
+// jsonFieldName returns the name of a struct field in JSON, or "" if the field is not marshaled.
+func jsonFieldName(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		return ""
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	default:
+		return name
+	}
+}
+
+func jsonFieldNames(t reflect.Type) []string {
+	if t.Kind() != reflect.Struct {
+		return []string{}
+	}
+	names := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		if name := jsonFieldName(t.Field(i)); name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func hasJSONField(t reflect.Type, path []string) bool {
+	if len(path) == 0 {
+		return true
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if jsonFieldName(t.Field(i)) == path[0] {
+			return hasJSONField(t.Field(i).Type, path[1:])
+		}
+	}
+	return false
+}
+
+// jsonFieldValue returns the value of the field of an item at a path of JSON names,
+// or an invalid value if a field on the path is nil.
+func jsonFieldValue(value reflect.Value, path []string) reflect.Value {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	if len(path) == 0 {
+		return value
+	}
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	for i := 0; i < value.NumField(); i++ {
+		if jsonFieldName(value.Type().Field(i)) == path[0] {
+			return jsonFieldValue(value.Field(i), path[1:])
+		}
+	}
+	return reflect.Value{}
+}
+
+// compareValues compares two values of the same field.
+func compareValues(a, b reflect.Value) int {
+	if aTime, isTime := a.Interface().(time.Time); isTime {
+		return aTime.Compare(b.Interface().(time.Time))
+	}
+
+	switch a.Kind() {
+	case reflect.String:
+		return strings.Compare(a.String(), b.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+	case reflect.Bool:
+		return cmp.Compare(boolToInt(a.Bool()), boolToInt(b.Bool()))
+	default:
+		return strings.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
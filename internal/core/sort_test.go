@@ -0,0 +1,109 @@
+package core_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+type testSortVolume struct {
+	Name      string     `json:"name"`
+	Size      uint64     `json:"size"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func testSortCommands() *core.Commands {
+	date := func(day int) *time.Time {
+		d := time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	volumes := []*testSortVolume{
+		{Name: "b", Size: 20, CreatedAt: date(2)},
+		{Name: "c", Size: 10, CreatedAt: nil},
+		{Name: "a", Size: 20, CreatedAt: date(3)},
+		{Name: "d", Size: 5, CreatedAt: date(1)},
+	}
+
+	return core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "volume",
+			Verb:                 "list",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (i interface{}, e error) {
+				return volumes, nil
+			},
+		},
+	)
+}
+
+func sortedVolumeNames(t *testing.T, ctx *core.CheckFuncCtx) []string {
+	t.Helper()
+	names := []string(nil)
+	for _, volume := range ctx.Result.([]*testSortVolume) {
+		names = append(names, volume.Name)
+	}
+	return names
+}
+
+func Test_Sort(t *testing.T) {
+	t.Run("Ascending", core.Test(&core.TestConfig{
+		Commands: testSortCommands(),
+		Cmd:      "scw test volume list --sort name",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, []string{"a", "b", "c", "d"}, sortedVolumeNames(t, ctx))
+			},
+		),
+	}))
+
+	t.Run("Descending with missing values", core.Test(&core.TestConfig{
+		Commands: testSortCommands(),
+		Cmd:      "scw test volume list --sort -created_at",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, []string{"a", "b", "d", "c"}, sortedVolumeNames(t, ctx))
+			},
+		),
+	}))
+
+	t.Run("Several fields", core.Test(&core.TestConfig{
+		Commands: testSortCommands(),
+		Cmd:      "scw test volume list --sort -size,name",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, []string{"a", "b", "c", "d"}, sortedVolumeNames(t, ctx))
+			},
+		),
+	}))
+
+	t.Run("Sorted before pagination", core.Test(&core.TestConfig{
+		Commands: testSortCommands(),
+		Cmd:      "scw test volume list --sort size --page-size 2",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				items := ctx.Result.(*core.PagedResult).Items.([]*testSortVolume)
+				assert.Equal(t, "d", items[0].Name)
+				assert.Equal(t, "c", items[1].Name)
+			},
+		),
+	}))
+
+	t.Run("Unknown field", core.Test(&core.TestConfig{
+		Commands: testSortCommands(),
+		Cmd:      "scw test volume list --sort state",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+	}))
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"sort"
+	"strings"
+)
+
+// suggestionMaxDistance is the maximum number of edits between a mistyped value and a suggestion.
+const suggestionMaxDistance = 2
+
+// suggestionMaxCount is the maximum number of suggestions given for a mistyped value.
+const suggestionMaxCount = 3
+
+// closestSuggestions returns the candidates closest to a mistyped value, the closest first.
+// Candidates starting with the value are suggested too, as it may be abbreviated.
+func closestSuggestions(value string, candidates []string) []string {
+	distances := map[string]int{}
+	suggestions := []string(nil)
+	for _, candidate := range candidates {
+		if _, exists := distances[candidate]; exists || candidate == value {
+			continue
+		}
+		distance := levenshteinDistance(value, candidate)
+		if distance > suggestionMaxDistance && (value == "" || !strings.HasPrefix(candidate, value)) {
+			continue
+		}
+		distances[candidate] = distance
+		suggestions = append(suggestions, candidate)
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if distances[suggestions[i]] != distances[suggestions[j]] {
+			return distances[suggestions[i]] < distances[suggestions[j]]
+		}
+		return suggestions[i] < suggestions[j]
+	})
+	if len(suggestions) > suggestionMaxCount {
+		suggestions = suggestions[:suggestionMaxCount]
+	}
+	return suggestions
+}
+
+// levenshteinDistance returns the minimum number of characters to insert, delete or substitute to change a into b.
+func levenshteinDistance(a string, b string) int {
+	ra, rb := []rune(strings.ToLower(a)), []rune(strings.ToLower(b))
+
+	// previous holds the distances between the first i-1 runes of a and each prefix of b
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			substitution := previous[j-1]
+			if ra[i-1] != rb[j-1] {
+				substitution++
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, substitution)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// didYouMean returns a hint suggesting values, or "" if there is none.
+func didYouMean(suggestions []string) string {
+	if len(suggestions) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(suggestions))
+	for i, suggestion := range suggestions {
+		quoted[i] = "'" + suggestion + "'"
+	}
+	return "Did you mean " + strings.Join(quoted, " or ") + "?"
+}
@@ -224,7 +224,7 @@ func createTestClient(t *testing.T, testConfig *TestConfig, httpClient *http.Cli
 		scw.WithDefaultProjectID("11111111-1111-1111-1111-111111111111"),
 		scw.WithUserAgent("cli-e2e-test"),
 		scw.WithHTTPClient(&http.Client{
-			Transport: &retryableHTTPTransport{transport: &SocketPassthroughTransport{}},
+			Transport: newRetryableHTTPTransport(&SocketPassthroughTransport{}),
 		}),
 	}
 
@@ -385,6 +385,7 @@ func Test(config *TestConfig) func(t *testing.T) {
 				Client:           client,
 				DisableTelemetry: true,
 				DisableAliases:   !config.EnableAliases,
+				AssumeYes:        true,
 				OverrideEnv:      overrideEnv,
 				OverrideExec:     overrideExec,
 				Ctx:              ctx,
@@ -452,6 +453,8 @@ func Test(config *TestConfig) func(t *testing.T) {
 				Logger:           cmdLogger,
 				HTTPClient:       httpClient,
 				Platform:         terminal.NewPlatform(buildInfo.GetUserAgent()),
+				// Destructive commands are only confirmed when the test mocks the answer
+				AssumeYes: len(config.PromptResponseMocks) == 0,
 			})
 
 			meta["CmdResult"] = result
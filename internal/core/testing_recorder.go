@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"log"
+	"net"
 	"net/http"
 	"regexp"
 	"testing"
@@ -18,6 +19,7 @@ func cassetteRequestFilter(i *cassette.Interaction) error {
 	delete(i.Request.Headers, "X-Auth-Token")
 	i.Request.URL = regexp.MustCompile("organization_id=[0-9a-f-]{36}").ReplaceAllString(i.Request.URL, "organization_id=11111111-1111-1111-1111-111111111111")
 	i.Request.URL = regexp.MustCompile(`api\.scaleway\.com/account/v1/tokens/[0-9a-f-]{36}`).ReplaceAllString(i.Request.URL, "api.scaleway.com/account/v1/tokens/11111111-1111-1111-1111-111111111111")
+	i.Request.URL = regexp.MustCompile(`account\.scaleway\.com/tokens/[0-9a-f-]{36}`).ReplaceAllString(i.Request.URL, "account.scaleway.com/tokens/11111111-1111-1111-1111-111111111111")
 	i.Request.URL = regexp.MustCompile(`api\.scaleway\.com/iam/v1alpha1/api-keys/SCW[0-9A-Z]{17}`).ReplaceAllString(i.Request.URL, "api.scaleway.com/iam/v1alpha1/api-keys/SCWXXXXXXXXXXXXXXXXX")
 
 	return nil
@@ -63,6 +65,11 @@ func cassetteMatcher(r *http.Request, i cassette.Request) bool {
 	return cassette.DefaultMatcher(r, i)
 }
 
+func isLoopbackRequest(r *http.Request) bool {
+	ip := net.ParseIP(r.URL.Hostname())
+	return r.URL.Hostname() == "localhost" || (ip != nil && ip.IsLoopback())
+}
+
 // getHTTPRecoder creates a new httpClient that records all HTTP requests in a cassette.
 // This cassette is then replayed whenever tests are executed again. This means that once the
 // requests are recorded in the cassette, no more real HTTP request must be made to run the tests.
@@ -89,7 +96,10 @@ func getHTTPRecoder(t *testing.T, update bool) (client *http.Client, cleanup fun
 
 	r.SetMatcher(cassetteMatcher)
 
-	return &http.Client{Transport: &retryableHTTPTransport{transport: r}}, func() {
+	// Local servers started by tests (e.g. using httptest) are never recorded
+	r.AddPassthrough(isLoopbackRequest)
+
+	return &http.Client{Transport: newRetryableHTTPTransport(r)}, func() {
 		assert.NoError(t, r.Stop()) // Make sure recorder is stopped once done with it
 	}, nil
 }
@@ -0,0 +1,34 @@
+package core
+
+import (
+	"context"
+)
+
+// runWaitWithTimeout stops waiting when the timeout of the command is reached.
+// The wait does not need to watch the context, not every wait loop of the SDK does.
+func runWaitWithTimeout(ctx context.Context, cmd *Command, waitFunc func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	timeout := extractMeta(ctx).timeout
+	if timeout <= 0 {
+		return waitFunc(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type waitResult struct {
+		result interface{}
+		err    error
+	}
+	done := make(chan waitResult, 1)
+	go func() {
+		result, err := waitFunc(ctx)
+		done <- waitResult{result: result, err: err}
+	}()
+
+	select {
+	case res := <-done:
+		return res.result, res.err
+	case <-ctx.Done():
+		return nil, WaitTimeoutError(cmd.Resource, timeout)
+	}
+}
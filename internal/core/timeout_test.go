@@ -0,0 +1,69 @@
+package core_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+func Test_WaitTimeout(t *testing.T) {
+	commands := core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "create",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (i interface{}, e error) {
+				return &core.SuccessResult{Message: "item created"}, nil
+			},
+			// The wait ignores its context like most wait loops of the SDK
+			WaitFunc: func(_ context.Context, _, respI interface{}) (interface{}, error) {
+				time.Sleep(time.Second)
+				return respI, nil
+			},
+		},
+	)
+
+	t.Run("Timeout reached", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw test item create -w --timeout 50ms",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stderr), "Timeout of 50ms reached while waiting for item")
+			},
+		),
+	}))
+
+	t.Run("Timeout not reached", core.Test(&core.TestConfig{
+		Commands: commands,
+		Cmd:      "scw test item create -w --timeout 5s",
+		Check:    core.TestCheckExitCode(0),
+	}))
+
+	t.Run("Timeout not kept by the next commands", core.Test(&core.TestConfig{
+		Commands: core.NewCommands(&core.Command{
+			Namespace:            "test",
+			Resource:             "timeout",
+			Verb:                 "get",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+				return core.ExtractHTTPClient(ctx).Timeout.String(), nil
+			},
+		}),
+		BeforeFunc: core.ExecBeforeCmd("scw test timeout get --timeout 5s"),
+		Cmd:        "scw test timeout get",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, "0s", ctx.Result)
+			},
+		),
+	}))
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
@@ -270,6 +271,27 @@ func ValidateProjectID() ArgSpecValidateFunc {
 	}
 }
 
+// ValidateAPIURL validates a non-required API URL, given as a string or a string pointer.
+func ValidateAPIURL() ArgSpecValidateFunc {
+	return func(argSpec *ArgSpec, valueI interface{}) error {
+		value, isStr := valueI.(string)
+		valuePtr, isPtr := valueI.(*string)
+		if !isStr && isPtr && valuePtr != nil {
+			value = *valuePtr
+		}
+
+		if value == "" && !argSpec.Required {
+			return nil
+		}
+		// The SDK accepts any parsable URL, the API needs a scheme and a host
+		apiURL, err := url.Parse(value)
+		if err != nil || (apiURL.Scheme != "http" && apiURL.Scheme != "https") || apiURL.Host == "" {
+			return InvalidAPIURLError(value)
+		}
+		return nil
+	}
+}
+
 func NewOneOfGroupManager(cmd *Command) *OneOfGroupManager {
 	manager := &OneOfGroupManager{
 		Groups:         make(map[string][]string),
@@ -9,6 +9,7 @@ import (
 	"github.com/alecthomas/assert"
 	"github.com/scaleway/scaleway-cli/v2/internal/args"
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 type Element struct {
@@ -1005,3 +1006,13 @@ func Test_ValidateOneOf(t *testing.T) {
 		})(t)
 	})
 }
+
+func Test_ValidateAPIURL(t *testing.T) {
+	argSpec := &core.ArgSpec{Name: "api-url"}
+
+	assert.NoError(t, core.ValidateAPIURL()(argSpec, ""))
+	assert.NoError(t, core.ValidateAPIURL()(argSpec, (*string)(nil)))
+	assert.NoError(t, core.ValidateAPIURL()(argSpec, "https://api.example.com"))
+	assert.NoError(t, core.ValidateAPIURL()(argSpec, scw.StringPtr("http://localhost:8080")))
+	assert.Equal(t, core.InvalidAPIURLError("api.example.com"), core.ValidateAPIURL()(argSpec, "api.example.com"))
+}
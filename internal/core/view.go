@@ -9,6 +9,10 @@ type View struct {
 	Title    string
 	Fields   []*ViewField
 	Sections []*ViewSection
+
+	// IDField is the field holding the identifier of the resources returned by the command.
+	// It is used by the ids output and defaults to ID.
+	IDField string
 }
 
 type ViewField struct {
@@ -45,5 +49,25 @@ func (v *View) getHumanMarshalerOpt() *human.MarshalOpt {
 		})
 	}
 	opt.Title = v.Title
+	opt.IDField = v.IDField
+	return opt
+}
+
+// humanMarshalerOpt returns the human marshal options of a command run with meta.
+// A list holding the resources of every locality shows the locality of each of them.
+func humanMarshalerOpt(meta *Meta, cmd *Command) *human.MarshalOpt {
+	opt := cmd.getHumanMarshalerOpt()
+	if opt == nil || len(opt.Fields) == 0 || meta.localityColumn == "" {
+		return opt
+	}
+	for _, field := range opt.Fields {
+		if field.FieldName == meta.localityColumn {
+			return opt
+		}
+	}
+	opt.Fields = append(opt.Fields, &human.MarshalFieldOpt{
+		FieldName: meta.localityColumn,
+		Label:     meta.localityColumn,
+	})
 	return opt
 }
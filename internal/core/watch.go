@@ -0,0 +1,90 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/terminal"
+	"github.com/spf13/cobra"
+)
+
+// defaultWatchInterval is the interval used when --watch is given without value
+const defaultWatchInterval = "2s"
+
+// clearScreen moves the cursor to the top left corner and clears the terminal
+const clearScreen = "\033[H\033[2J"
+
+// commandHasWatch returns whether the command can be re-run with --watch.
+func commandHasWatch(cmd *Command) bool {
+	return cmd.Run != nil && (cmd.Verb == "get" || cmd.Verb == "list")
+}
+
+func addWatchFlag(cobraCmd *cobra.Command) {
+	cobraCmd.PersistentFlags().String("watch", "", "re-run the command every 2 seconds, or at the given interval (e.g. --watch=10s), and redraw its output")
+	cobraCmd.PersistentFlags().Lookup("watch").NoOptDefVal = defaultWatchInterval
+}
+
+// getWatchIntervalFromFlags returns the interval requested with --watch, or 0 if the flag was not given.
+// A number without unit is a number of seconds.
+func getWatchIntervalFromFlags(cobraCmd *cobra.Command, cmd *Command) (time.Duration, error) {
+	if !commandHasWatch(cmd) {
+		return 0, nil
+	}
+
+	watch, err := cobraCmd.PersistentFlags().GetString("watch")
+	if err != nil || watch == "" {
+		return 0, nil
+	}
+
+	interval, err := time.ParseDuration(watch)
+	if seconds, atoiErr := strconv.Atoi(watch); atoiErr == nil {
+		interval, err = time.Duration(seconds)*time.Second, nil
+	}
+	if err != nil || interval <= 0 {
+		return 0, &CliError{
+			Err:  fmt.Errorf("invalid watch interval: %s", watch),
+			Hint: "interval must be a positive number of seconds or a duration, e.g. --watch=5 or --watch=1m",
+		}
+	}
+	return interval, nil
+}
+
+// runWatch calls runOnce at each interval and prints its result with the printer chosen with -o.
+// Human output is redrawn under a header, other formats are printed one after the other.
+// It only returns when runOnce fails or when the context is done.
+func runWatch(ctx context.Context, cmd *Command, interval time.Duration, runOnce func() (interface{}, error)) error {
+	meta := extractMeta(ctx)
+	// A pager would block the next redraw
+	printer := *meta.printer
+	printer.pager = false
+	redraw := printer.printerType == PrinterTypeHuman || printer.printerType == PrinterTypeWide
+
+	for runs := 0; ; runs++ {
+		result, err := runOnce()
+		if err != nil {
+			return err
+		}
+
+		if redraw {
+			// Outside a terminal, outputs are separated instead of redrawn
+			if terminal.IsTerm() {
+				_, _ = fmt.Fprint(meta.stdout, clearScreen)
+			} else if runs > 0 {
+				_, _ = fmt.Fprintln(meta.stdout)
+			}
+			_, _ = fmt.Fprintf(meta.stdout, "Every %s: %s\n\n", interval, cmd.GetCommandLine(meta.BinaryName))
+		}
+		err = printer.Print(result, humanMarshalerOpt(meta, cmd))
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package core_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+type testWatchItem struct {
+	Name  string
+	State string
+}
+
+// testWatchCommands returns a list command whose items change at each run, it fails after the given number of runs.
+func testWatchCommands(runsBeforeError int) *core.Commands {
+	runs := 0
+
+	return core.NewCommands(
+		&core.Command{
+			Namespace:            "test",
+			Resource:             "item",
+			Verb:                 "list",
+			AllowAnonymousClient: true,
+			ArgsType:             reflect.TypeOf(struct{}{}),
+			Run: func(_ context.Context, _ interface{}) (i interface{}, e error) {
+				runs++
+				if runs > runsBeforeError {
+					return nil, fmt.Errorf("run %d failed", runs)
+				}
+				return []*testWatchItem{{Name: "a", State: fmt.Sprintf("state-%d", runs)}}, nil
+			},
+		},
+	)
+}
+
+func Test_Watch(t *testing.T) {
+	t.Run("Redraw until error", core.Test(&core.TestConfig{
+		Commands: testWatchCommands(2),
+		Cmd:      "scw test item list --watch=1ms",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("Interval in seconds", core.Test(&core.TestConfig{
+		Commands: testWatchCommands(0),
+		Cmd:      "scw test item list --watch=5",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("JSON output", core.Test(&core.TestConfig{
+		Commands: testWatchCommands(2),
+		Cmd:      "scw test item list --watch=1ms -o json",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("Invalid interval", core.Test(&core.TestConfig{
+		Commands: testWatchCommands(1),
+		Cmd:      "scw test item list --watch=-1s",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+	}))
+}
@@ -0,0 +1,170 @@
+package docgen
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+type manData struct {
+	Cmd      *core.Command
+	Commands *core.Commands
+	SeeAlso  []string
+}
+
+const manTplStr = `.TH "{{ upper (page_name .Cmd) }}" "1" "" "scw" "Scaleway CLI"
+.SH NAME
+{{ page_name .Cmd }} \- {{ roff .Cmd.Short }}
+.SH SYNOPSIS
+.B {{ roff (.Cmd.GetUsage "scw" .Commands) }}
+{{- if .Cmd.Long }}
+.SH DESCRIPTION
+{{ roff .Cmd.Long }}
+{{- end }}
+{{- if .Cmd.ArgSpecs }}
+.SH ARGUMENTS
+{{- range $arg := .Cmd.ArgSpecs }}
+.TP
+.B {{ roff $arg.Name }}{{ with arg_spec_flags $arg }} ({{ roff . }}){{ end }}
+{{- with $arg.Short }}
+{{ roff . }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .Cmd.Examples }}
+.SH EXAMPLES
+{{- range $example := .Cmd.Examples }}
+.PP
+{{ roff $example.Short }}
+.RS 4
+.nf
+{{ roff ($example.GetCommandLine "scw" $.Cmd) }}
+.fi
+.RE
+{{- end }}
+{{- end }}
+{{- if .SeeAlso }}
+.SH SEE ALSO
+{{ see_also .SeeAlso }}
+{{- end }}
+`
+
+// GenerateManPages generates a roff man page in section 1 for each command of a given list of commands
+func GenerateManPages(commands *core.Commands, outDir string) error {
+	tpl := newManTemplate()
+
+	for _, c := range commands.GetAll() {
+		if c.Hidden {
+			continue
+		}
+
+		buffer := bytes.Buffer{}
+		err := tpl.Execute(&buffer, &manData{
+			Cmd:      c,
+			Commands: commands,
+			SeeAlso:  manSeeAlso(commands, c),
+		})
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(path.Join(outDir, manPageName(c)+".1"), buffer.Bytes(), 0600)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// manPageName returns the name of the man page of a command, e.g. scw-instance-server-list
+func manPageName(c *core.Command) string {
+	parts := []string{"scw"}
+	for _, part := range []string{c.Namespace, c.Resource, c.Verb} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// manSeeAlso returns the man pages of the parent and of the subcommands of a command
+func manSeeAlso(commands *core.Commands, c *core.Command) []string {
+	pages := []string(nil)
+	if c.Resource != "" {
+		parent := &core.Command{Namespace: c.Namespace}
+		if c.Verb != "" {
+			parent.Resource = c.Resource
+		}
+		pages = append(pages, manPageName(parent))
+	}
+
+	for _, sub := range commands.GetSortedCommand() {
+		if sub.Hidden || sub.Namespace != c.Namespace {
+			continue
+		}
+		isChild := c.Resource == "" && sub.Resource != "" && sub.Verb == "" ||
+			c.Resource != "" && c.Verb == "" && sub.Resource == c.Resource && sub.Verb != ""
+		if isChild {
+			pages = append(pages, manPageName(sub))
+		}
+	}
+	return pages
+}
+
+// roffEscape escapes text so that roff renders it as is
+func roffEscape(s string) string {
+	s = regexp.MustCompile(ansi).ReplaceAllString(s, "")
+	s = strings.ReplaceAll(s, `\`, `\e`)
+	s = strings.ReplaceAll(s, "-", `\-`)
+
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	for i, line := range lines {
+		switch {
+		case strings.TrimSpace(line) == "":
+			lines[i] = ".PP"
+		case strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'"):
+			lines[i] = `\&` + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func newManTemplate() *template.Template {
+	tpl := template.New("man")
+	tpl = tpl.Funcs(map[string]interface{}{
+		"roff":      roffEscape,
+		"upper":     strings.ToUpper,
+		"page_name": manPageName,
+		"arg_spec_flags": func(arg *core.ArgSpec) string {
+			parts := []string(nil)
+			if arg.Deprecated {
+				parts = append(parts, "deprecated")
+			}
+			if arg.Required {
+				parts = append(parts, "required")
+			}
+			if arg.Default != nil {
+				_, doc := arg.Default(core.GetDocGenContext())
+				parts = append(parts, fmt.Sprintf("default: %s", doc))
+			}
+			if len(arg.EnumValues) > 0 {
+				parts = append(parts, fmt.Sprintf("one of: %s", strings.Join(arg.EnumValues, ", ")))
+			}
+			return strings.Join(parts, ", ")
+		},
+		"see_also": func(pages []string) string {
+			refs := make([]string, len(pages))
+			for i, page := range pages {
+				refs[i] = fmt.Sprintf(`\fB%s\fR(1)`, roffEscape(page))
+			}
+			return strings.Join(refs, ", ")
+		},
+	})
+	return template.Must(tpl.Parse(manTplStr))
+}
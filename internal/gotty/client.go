@@ -3,8 +3,11 @@
 package gotty
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"time"
@@ -23,6 +26,9 @@ const (
 	inputCode          = '1'
 	pingCode           = '2'
 	resizeTerminalCode = '3'
+
+	// detachKey is the key typed to close the connection, Ctrl+q
+	detachKey = 0x11
 )
 
 type Client struct {
@@ -40,17 +46,13 @@ func NewClient(zone scw.Zone, serverID string, secretKey string) (*Client, error
 	}, nil
 }
 
-func (c *Client) Connect() error {
+// dial opens an authenticated websocket to the console of the server.
+func (c *Client) dial(ctx context.Context) (*websocket.Conn, error) {
 	wsDialer := websocket.Dialer{}
-	conn, _, err := wsDialer.Dial(c.wsURL, nil)
+	conn, _, err := wsDialer.DialContext(ctx, c.wsURL, nil)
 	if err != nil {
-		return fmt.Errorf("failed to dial websocket: %w", err)
+		return nil, fmt.Errorf("failed to dial websocket: %w", err)
 	}
-	defer func() {
-		// Websocket protocol require the server to close the connection.
-		// This sent a close request.
-		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")) //nolint:errcheck
-	}()
 
 	// This is how scaleway implement gotty authentication
 	err = conn.WriteJSON(map[string]string{
@@ -58,9 +60,25 @@ func (c *Client) Connect() error {
 		"Arguments": "?" + url.Values{"arg": []string{c.secretKey, c.serverID}}.Encode(),
 	})
 	if err != nil {
-		return fmt.Errorf("failed to send auth json: %w", err)
+		closeConn(conn)
+		return nil, fmt.Errorf("failed to send auth json: %w", err)
 	}
 
+	return conn, nil
+}
+
+// closeConn sends a close request, the websocket protocol requires the server to close the connection.
+func closeConn(conn *websocket.Conn) {
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")) //nolint:errcheck
+}
+
+func (c *Client) Connect() error {
+	conn, err := c.dial(context.Background())
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn)
+
 	cns, err := console.ConsoleFromFile(os.Stdin)
 	if err != nil {
 		return fmt.Errorf("os.Stdin doesn't seems to be a valid terminal: %w", err)
@@ -126,9 +144,19 @@ func (c *Client) Connect() error {
 			if len(message) == 0 {
 				return nil
 			}
-			err = conn.WriteMessage(websocket.TextMessage, append([]byte{inputCode}, message...))
-			if err != nil {
-				return fmt.Errorf("failed to write message on websocket: %w", err)
+			// The detach key is handled locally, the input typed before it is still sent
+			detachIndex := bytes.IndexByte(message, detachKey)
+			if detachIndex >= 0 {
+				message = message[:detachIndex]
+			}
+			if len(message) > 0 {
+				err = conn.WriteMessage(websocket.TextMessage, append([]byte{inputCode}, message...))
+				if err != nil {
+					return fmt.Errorf("failed to write message on websocket: %w", err)
+				}
+			}
+			if detachIndex >= 0 {
+				return nil
 			}
 
 		// We make sure to send a ping every 30s to keep the connection alive.
@@ -202,3 +230,60 @@ func consoleReader(cns console.Console) (chan []byte, chan error) {
 
 	return readChan, errChan
 }
+
+// ReadOutput writes the output of the console to w without sending any input.
+// It returns once no output was received for idleTimeout, or when ctx is done if idleTimeout is 0.
+func (c *Client) ReadOutput(ctx context.Context, w io.Writer, idleTimeout time.Duration) error {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn)
+
+	wsChan, wsErrChan := websocketReader(conn)
+
+	for {
+		timeout := 30 * time.Second
+		if idleTimeout > 0 {
+			timeout = idleTimeout
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case message := <-wsChan:
+			// If message is empty, connection was closed
+			if len(message) == 0 {
+				return nil
+			}
+			if message[0] != outputCode {
+				continue
+			}
+			buf, err := base64.StdEncoding.DecodeString(string(message[1:]))
+			if err != nil {
+				return fmt.Errorf("failed to decode base64 output payload: %w", err)
+			}
+			_, err = w.Write(buf)
+			if err != nil {
+				return err
+			}
+
+		// Either no output was received for idleTimeout or the connection has to be kept alive with a ping
+		case <-time.After(timeout):
+			if idleTimeout > 0 {
+				return nil
+			}
+			err = conn.WriteMessage(websocket.TextMessage, []byte{pingCode})
+			if err != nil {
+				return fmt.Errorf("failed to ping websocket: %w", err)
+			}
+
+		case err := <-wsErrChan:
+			if err != nil {
+				return fmt.Errorf("websocket reader error: %w", err)
+			}
+			return nil
+		}
+	}
+}
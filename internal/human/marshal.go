@@ -267,16 +267,47 @@ func GetStructFieldsIndex(v reflect.Type) [][]int {
 }
 
 func marshalSlice(slice reflect.Value, opt *MarshalOpt) (string, error) {
-	// Resole itemType and get rid of all pointer level if needed.
+	// If itemType is not a struct (e.g []string) we just stringify it
+	if sliceItemType(slice).Kind() != reflect.Struct {
+		return fmt.Sprint(slice.Interface()), nil
+	}
+
+	grid, err := marshalGrid(slice, opt)
+	if err != nil {
+		return "", err
+	}
+	return formatGrid(grid, !opt.DisableShrinking)
+}
+
+// MarshalGrid returns the rows of a slice of structs as a grid of cells, the first row being the header row.
+// Columns are selected the same way as for the human table output.
+func MarshalGrid(data interface{}, opt *MarshalOpt) ([][]string, error) {
+	if opt == nil {
+		opt = &MarshalOpt{}
+	}
+
+	slice := reflect.ValueOf(data)
+	for slice.Kind() == reflect.Ptr || slice.Kind() == reflect.Interface {
+		slice = slice.Elem()
+	}
+	if slice.Kind() != reflect.Slice || sliceItemType(slice).Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot marshal %T as a grid, a list of objects is expected", data)
+	}
+
+	return marshalGrid(slice, opt)
+}
+
+// sliceItemType returns the item type of a slice without any pointer level.
+func sliceItemType(slice reflect.Value) reflect.Type {
 	itemType := slice.Type().Elem()
 	for itemType.Kind() == reflect.Ptr {
 		itemType = itemType.Elem()
 	}
+	return itemType
+}
 
-	// If itemType is not a struct (e.g []string) we just stringify it
-	if itemType.Kind() != reflect.Struct {
-		return fmt.Sprint(slice.Interface()), nil
-	}
+func marshalGrid(slice reflect.Value, opt *MarshalOpt) ([][]string, error) {
+	itemType := sliceItemType(slice)
 
 	// If there is no Field in opt we generated default one using reflect
 	if len(opt.Fields) == 0 {
@@ -289,7 +320,7 @@ func marshalSlice(slice reflect.Value, opt *MarshalOpt) (string, error) {
 	for _, f := range opt.Fields {
 		_, err := gofields.GetType(itemType, f.FieldName)
 		if err != nil {
-			return "", &UnknownFieldError{
+			return nil, &UnknownFieldError{
 				FieldName:   f.FieldName,
 				ValidFields: gofields.ListFields(itemType),
 			}
@@ -328,13 +359,13 @@ func marshalSlice(slice reflect.Value, opt *MarshalOpt) (string, error) {
 				str, err = Marshal(fieldValue.Interface(), subOpts)
 			}
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 			row = append(row, str)
 		}
 		grid = append(grid, row)
 	}
-	return formatGrid(grid, !opt.DisableShrinking)
+	return grid, nil
 }
 
 // marshalInlineSlice transforms nested scalar slices in an inline string representation
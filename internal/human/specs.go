@@ -18,6 +18,9 @@ type MarshalOpt struct {
 
 	// DisableShrinking will disable columns shrinking based on terminal size
 	DisableShrinking bool
+
+	// IDField is the field holding the identifier of a resource, it is used to print only identifiers
+	IDField string
 }
 
 func (m *MarshalOpt) subOption(section string) *MarshalOpt {
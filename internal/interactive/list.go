@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -17,9 +18,14 @@ type ListPrompt struct {
 	Choices []string
 	// DefaultIndex is the element that will be selected when starting prompt
 	DefaultIndex int
+	// Filter enables filtering the choices by typing, the choices containing the typed characters in order are kept
+	Filter bool
 
 	cursor    int
 	cancelled bool
+	query     string
+	// matches are the indexes of the choices shown
+	matches []int
 }
 
 func (m *ListPrompt) Init() tea.Cmd {
@@ -30,6 +36,9 @@ func (m *ListPrompt) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	// Key is pressed
 	case tea.KeyMsg:
+		if m.Filter {
+			return m.updateFilter(msg)
+		}
 		switch msg.String() {
 		case "ctrl+c", "q":
 			m.cancelled = true
@@ -39,7 +48,7 @@ func (m *ListPrompt) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.cursor--
 			}
 		case "down", "j":
-			if m.cursor < len(m.Choices)-1 {
+			if m.cursor < len(m.matches)-1 {
 				m.cursor++
 			}
 		case "enter", " ":
@@ -50,24 +59,74 @@ func (m *ListPrompt) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// updateFilter handles keys when choices can be filtered, letters are then part of the filter instead of shortcuts
+func (m *ListPrompt) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC, tea.KeyEsc:
+		m.cancelled = true
+		return m, tea.Quit
+	case tea.KeyUp:
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case tea.KeyDown:
+		if m.cursor < len(m.matches)-1 {
+			m.cursor++
+		}
+	case tea.KeyEnter:
+		if len(m.matches) > 0 {
+			return m, tea.Quit
+		}
+	case tea.KeyBackspace:
+		if m.query != "" {
+			runes := []rune(m.query)
+			m.setQuery(string(runes[:len(runes)-1]))
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.setQuery(m.query + string(msg.Runes))
+	}
+
+	return m, nil
+}
+
+// setQuery filters the choices with a new query and selects the first one matching
+func (m *ListPrompt) setQuery(query string) {
+	m.query = query
+	m.cursor = 0
+	m.matches = m.matches[:0]
+	for i, choice := range m.Choices {
+		if fuzzyMatch(query, choice) {
+			m.matches = append(m.matches, i)
+		}
+	}
+}
+
 func (m *ListPrompt) View() string {
 	s := m.Prompt + "\n\n"
+	if m.Filter {
+		s += fmt.Sprintf("Filter: %s\n\n", m.query)
+	}
 
-	for i, choice := range m.Choices {
+	for i, choiceIndex := range m.matches {
 		if m.cursor == i {
-			s += fmt.Sprintf("> %s\n", choice)
+			s += fmt.Sprintf("> %s\n", m.Choices[choiceIndex])
 		} else {
-			s += fmt.Sprintf("%s\n", choice)
+			s += fmt.Sprintf("%s\n", m.Choices[choiceIndex])
 		}
 	}
 
-	s += "\nPress enter or space for select.\n"
+	if m.Filter {
+		s += "\nType to filter, press enter for select.\n"
+	} else {
+		s += "\nPress enter or space for select.\n"
+	}
 
 	return s
 }
 
 // Execute start the prompt and return the selected index
 func (m *ListPrompt) Execute(ctx context.Context) (int, error) {
+	m.setQuery("")
 	m.cursor = m.DefaultIndex
 
 	opts := []tea.ProgramOption{
@@ -80,6 +139,9 @@ func (m *ListPrompt) Execute(ctx context.Context) (int, error) {
 			defaultReader: os.Stdin,
 		}))
 		opts = append(opts, tea.WithOutput(bytes.NewBuffer([]byte{})))
+	} else {
+		// Like the other prompts, do not mix with the output of the command which may be piped
+		opts = append(opts, tea.WithOutput(os.Stderr))
 	}
 
 	p := tea.NewProgram(m, opts...)
@@ -92,5 +154,18 @@ func (m *ListPrompt) Execute(ctx context.Context) (int, error) {
 		return -1, fmt.Errorf("prompt cancelled")
 	}
 
-	return m.cursor, nil
+	return m.matches[m.cursor], nil
+}
+
+// fuzzyMatch returns whether s contains the characters of pattern in the same order, ignoring case
+func fuzzyMatch(pattern string, s string) bool {
+	s = strings.ToLower(s)
+	for _, r := range strings.ToLower(pattern) {
+		i := strings.IndexRune(s, r)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(string(r)):]
+	}
+	return true
 }
@@ -11,6 +11,7 @@ type ListPrompt struct {
 	Prompt       string
 	Choices      []string
 	DefaultIndex int
+	Filter       bool
 }
 
 func (m *ListPrompt) Execute(ctx context.Context) (int, error) {
@@ -0,0 +1,114 @@
+//go:build !wasm
+
+package interactive
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type PromptMultiSelectConfig struct {
+	Ctx     context.Context
+	Prompt  string
+	Choices []string
+	// Selected are the indexes of the choices selected when starting the prompt
+	Selected []int
+}
+
+// PromptMultiSelectWithConfig lets the user select several choices and returns their indexes in the order of the choices.
+// Space toggles the choice under the cursor, typing filters the choices and enter validates the selection.
+func PromptMultiSelectWithConfig(config *PromptMultiSelectConfig) ([]int, error) {
+	m := &multiSelectPrompt{
+		ListPrompt: ListPrompt{
+			Prompt:  config.Prompt,
+			Choices: config.Choices,
+			Filter:  true,
+		},
+		selected: make([]bool, len(config.Choices)),
+	}
+	for _, i := range config.Selected {
+		m.selected[i] = true
+	}
+	m.setQuery("")
+
+	opts := []tea.ProgramOption{
+		tea.WithContext(config.Ctx),
+	}
+	if hasMockedResponse(config.Ctx) {
+		opts = append(opts, tea.WithInput(&mockResponseReader{
+			ctx:           config.Ctx,
+			defaultReader: os.Stdin,
+		}))
+		opts = append(opts, tea.WithOutput(bytes.NewBuffer([]byte{})))
+	} else {
+		opts = append(opts, tea.WithOutput(os.Stderr))
+	}
+
+	_, err := tea.NewProgram(m, opts...).Run()
+	if err != nil {
+		return nil, fmt.Errorf("error running prompt: %w", err)
+	}
+	if m.cancelled {
+		return nil, &InterruptError{}
+	}
+
+	indexes := []int(nil)
+	for i, selected := range m.selected {
+		if selected {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes, nil
+}
+
+// multiSelectPrompt is a filterable list prompt whose choices are toggled with space
+type multiSelectPrompt struct {
+	ListPrompt
+
+	selected []bool
+}
+
+func (m *multiSelectPrompt) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, isKey := msg.(tea.KeyMsg)
+	if !isKey {
+		return m, nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeySpace:
+		if len(m.matches) > 0 {
+			choice := m.matches[m.cursor]
+			m.selected[choice] = !m.selected[choice]
+		}
+	case tea.KeyEnter:
+		return m, tea.Quit
+	default:
+		m.updateFilter(keyMsg)
+	}
+	return m, nil
+}
+
+func (m *multiSelectPrompt) View() string {
+	s := m.Prompt + "\n\n"
+	s += fmt.Sprintf("Filter: %s\n\n", m.query)
+
+	for i, choiceIndex := range m.matches {
+		cursor := " "
+		if m.cursor == i {
+			cursor = ">"
+		}
+		checked := " "
+		if m.selected[choiceIndex] {
+			checked = "x"
+		}
+		s += fmt.Sprintf("%s [%s] %s\n", cursor, checked, m.Choices[choiceIndex])
+	}
+
+	s += "\nType to filter, press space for select and enter for validate.\n"
+
+	return s
+}
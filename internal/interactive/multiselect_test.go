@@ -0,0 +1,23 @@
+package interactive_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromptMultiSelectWithConfig(t *testing.T) {
+	ctx := interactive.InjectMockResponseToContext(context.Background(), []string{"db \r"})
+
+	indexes, err := interactive.PromptMultiSelectWithConfig(&interactive.PromptMultiSelectConfig{
+		Ctx:      ctx,
+		Prompt:   "Select servers",
+		Choices:  []string{"web", "db", "cache"},
+		Selected: []int{2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, indexes)
+}
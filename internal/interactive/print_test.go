@@ -6,7 +6,9 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/fatih/color"
 	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+	"github.com/scaleway/scaleway-cli/v2/internal/terminal"
 
 	"github.com/alecthomas/assert"
 )
@@ -61,3 +63,20 @@ func TestPrintf(t *testing.T) {
 
 	assert.Equal(t, "Test 42", buffer.String())
 }
+
+func TestPrintfStripStyleWhenNotTerminal(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = noColor }()
+
+	buffer := &bytes.Buffer{}
+	interactive.SetOutputWriter(buffer)
+	interactive.IsInteractive = true
+	defer func() { interactive.IsInteractive = false }()
+
+	_, err := interactive.Printf("%s\n", terminal.Style("Initialization completed", color.Bold, color.FgGreen))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Initialization completed\n", buffer.String())
+	assert.NotContains(t, buffer.String(), "\x1b")
+}
@@ -0,0 +1,135 @@
+package interactive
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	// progressRefreshInterval is the minimal time between two renderings of a progress component
+	progressRefreshInterval = 100 * time.Millisecond
+
+	// progressBarWidth is the number of characters of a progress bar, without brackets
+	progressBarWidth = 30
+
+	// clearLine moves the cursor to the beginning of the line and clears it
+	clearLine = "\r\033[K"
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// progressEnabled returns whether progress components must be rendered.
+// They are only shown in an interactive session whose stdout is a terminal.
+func progressEnabled() bool {
+	return IsInteractive && TerminalOutput
+}
+
+// Spinner shows an animated spinner with the elapsed time while a long operation runs.
+type Spinner struct {
+	message string
+	start   time.Time
+	stop    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// StartSpinner shows a spinner with message until Stop is called.
+// Nothing is shown when progress is disabled.
+func StartSpinner(message string) *Spinner {
+	s := &Spinner{
+		message: message,
+		start:   time.Now(),
+		stop:    make(chan struct{}),
+	}
+	if !progressEnabled() {
+		return s
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(progressRefreshInterval)
+		defer ticker.Stop()
+		for frame := 0; ; frame++ {
+			elapsed := time.Since(s.start).Truncate(time.Second)
+			_, _ = fmt.Fprintf(outputWriter, "%s%s %s (%s)", clearLine, spinnerFrames[frame%len(spinnerFrames)], s.message, elapsed)
+			select {
+			case <-s.stop:
+				_, _ = fmt.Fprint(outputWriter, clearLine)
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return s
+}
+
+// Stop removes the spinner.
+func (s *Spinner) Stop() {
+	select {
+	case <-s.stop:
+		return
+	default:
+		close(s.stop)
+	}
+	s.wg.Wait()
+}
+
+// ProgressReader shows a progress bar of the bytes read from an underlying reader.
+type ProgressReader struct {
+	reader     io.Reader
+	message    string
+	total      int64
+	read       int64
+	lastRender time.Time
+}
+
+// NewProgressReader wraps reader to show the progress of reading total bytes, total is 0 when unknown.
+// Finish must be called once reading is done. Nothing is shown when progress is disabled.
+func NewProgressReader(reader io.Reader, message string, total int64) *ProgressReader {
+	return &ProgressReader{
+		reader:  reader,
+		message: message,
+		total:   total,
+	}
+}
+
+func (r *ProgressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	if time.Since(r.lastRender) >= progressRefreshInterval {
+		r.render()
+	}
+	return n, err
+}
+
+// Finish removes the progress bar.
+func (r *ProgressReader) Finish() {
+	if progressEnabled() {
+		_, _ = fmt.Fprint(outputWriter, clearLine)
+	}
+}
+
+func (r *ProgressReader) render() {
+	if !progressEnabled() {
+		return
+	}
+	r.lastRender = time.Now()
+
+	if r.total <= 0 {
+		_, _ = fmt.Fprintf(outputWriter, "%s%s %s", clearLine, r.message, humanize.Bytes(uint64(r.read)))
+		return
+	}
+
+	ratio := float64(r.read) / float64(r.total)
+	if ratio > 1 {
+		ratio = 1
+	}
+	filled := int(ratio * progressBarWidth)
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", progressBarWidth-filled)
+	_, _ = fmt.Fprintf(outputWriter, "%s%s [%s] %3.0f%% %s / %s", clearLine, r.message, bar, ratio*100, humanize.Bytes(uint64(r.read)), humanize.Bytes(uint64(r.total)))
+}
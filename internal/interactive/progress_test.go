@@ -0,0 +1,64 @@
+//go:build !wasm
+
+package interactive_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+
+	"github.com/alecthomas/assert"
+)
+
+func enableProgress(t *testing.T, buffer *bytes.Buffer) {
+	t.Helper()
+	interactive.SetOutputWriter(buffer)
+	interactive.IsInteractive = true
+	interactive.TerminalOutput = true
+	t.Cleanup(func() {
+		interactive.IsInteractive = false
+		interactive.TerminalOutput = false
+	})
+}
+
+func TestSpinner(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	enableProgress(t, buffer)
+
+	spinner := interactive.StartSpinner("Waiting for server")
+	time.Sleep(50 * time.Millisecond)
+	spinner.Stop()
+	spinner.Stop()
+
+	assert.Contains(t, buffer.String(), "Waiting for server (0s)")
+}
+
+func TestSpinnerDisabled(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	enableProgress(t, buffer)
+	interactive.TerminalOutput = false
+
+	spinner := interactive.StartSpinner("Waiting for server")
+	spinner.Stop()
+
+	assert.Equal(t, "", buffer.String())
+}
+
+func TestProgressReader(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	enableProgress(t, buffer)
+
+	content := strings.Repeat("a", 2000)
+	reader := interactive.NewProgressReader(strings.NewReader(content), "Downloading dump", int64(len(content)))
+	read, err := io.ReadAll(reader)
+	reader.Finish()
+	assert.NoError(t, err)
+	assert.Equal(t, content, string(read))
+
+	assert.Contains(t, buffer.String(), "Downloading dump [")
+	assert.Contains(t, buffer.String(), "/ 2.0 kB")
+}
@@ -50,3 +50,14 @@ type ReadlineConfig struct {
 func Readline(config *ReadlineConfig) (string, error) {
 	return "", fmt.Errorf("prompt is disabled for this build")
 }
+
+type PromptMultiSelectConfig struct {
+	Ctx      context.Context
+	Prompt   string
+	Choices  []string
+	Selected []int
+}
+
+func PromptMultiSelectWithConfig(config *PromptMultiSelectConfig) ([]int, error) {
+	return nil, fmt.Errorf("prompt is disabled for this build")
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/chzyer/readline"
+	"github.com/mattn/go-colorable"
 	isatty "github.com/mattn/go-isatty"
 	"github.com/scaleway/scaleway-sdk-go/validation"
 )
@@ -18,8 +19,8 @@ var (
 	// IsInteractive must be set to print anything with Printer functions (Print, Printf,...).
 	IsInteractive = isInteractive()
 
-	// TerminalOutput define if the
-	TerminalOutput = IsInteractive
+	// TerminalOutput defines if stdout is a terminal, progress components are only shown when it is set.
+	TerminalOutput = isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
 
 	// outputWriter is the writer used by Printer functions (Print, Printf,...).
 	outputWriter io.Writer
@@ -30,11 +31,24 @@ var (
 
 // SetOutputWriter set the output writer that will be used by both Printer functions (Print, Printf,...) and
 // readline prompter. This should be called once from the bootstrap function.
+// Styling is stripped when w is not a terminal, whatever the color settings are.
 func SetOutputWriter(w io.Writer) {
+	if !isTerminalWriter(w) {
+		w = colorable.NewNonColorable(w)
+	}
 	outputWriter = w
 	readline.Stdout = newWriteCloser(w)
 }
 
+// isTerminalWriter returns whether w writes to a terminal.
+// Writers which are not files, like buffers, are never terminals except for colorable ones.
+func isTerminalWriter(w io.Writer) bool {
+	if f, isFile := w.(interface{ Fd() uintptr }); isFile {
+		return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+	}
+	return isColorableTerminal(w)
+}
+
 // we should expect both Stdin and Stderr to enable interactive mode
 func isInteractive() bool {
 	return isatty.IsTerminal(os.Stdin.Fd()) && isatty.IsTerminal(os.Stderr.Fd()) ||
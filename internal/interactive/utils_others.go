@@ -0,0 +1,10 @@
+//go:build !windows && !wasm
+
+package interactive
+
+import "io"
+
+// isColorableTerminal returns false as colorable writers are files outside of windows.
+func isColorableTerminal(_ io.Writer) bool {
+	return false
+}
@@ -12,6 +12,9 @@ var (
 	// IsInteractive must be set to print anything with Printer functions (Print, Printf,...).
 	IsInteractive = false
 
+	// TerminalOutput defines if stdout is a terminal, progress components are only shown when it is set.
+	TerminalOutput = false
+
 	// OutputWriter is the writer used by Printer functions (Print, Printf,...).
 	outputWriter io.Writer
 )
@@ -0,0 +1,15 @@
+//go:build windows
+
+package interactive
+
+import (
+	"io"
+
+	"github.com/mattn/go-colorable"
+)
+
+// isColorableTerminal returns whether w is a colorable writer, which is only created for a console on windows.
+func isColorableTerminal(w io.Writer) bool {
+	_, isColorable := w.(*colorable.Writer)
+	return isColorable
+}
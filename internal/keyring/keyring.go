@@ -0,0 +1,73 @@
+package keyring
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+const (
+	// service is the name under which secrets are stored in the system keyring
+	service = "scaleway-cli"
+
+	// prefix marks a config value that references a keyring entry
+	prefix = "keyring:"
+)
+
+// ErrNotFound is returned when a reference points to a missing keyring entry
+var ErrNotFound = errors.New("secret not found in the system keyring")
+
+// IsReference returns whether value references a keyring entry
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Store saves secret in the system keyring for the given profile and returns
+// the reference to write in the config file instead of the secret.
+func Store(profileName string, secret string) (string, error) {
+	err := keyring.Set(service, profileName, secret)
+	if err != nil {
+		return "", fmt.Errorf("cannot store secret in the system keyring: %w", err)
+	}
+
+	return prefix + profileName, nil
+}
+
+// Resolve returns the secret referenced by reference
+func Resolve(reference string) (string, error) {
+	if !IsReference(reference) {
+		return "", fmt.Errorf("value is not a keyring reference")
+	}
+
+	secret, err := keyring.Get(service, strings.TrimPrefix(reference, prefix))
+	if errors.Is(err, keyring.ErrNotFound) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot read secret from the system keyring: %w", err)
+	}
+
+	return secret, nil
+}
+
+// Delete removes the secret referenced by reference from the system keyring.
+// A missing secret is not an error, it may have been removed from the keyring directly.
+func Delete(reference string) error {
+	if !IsReference(reference) {
+		return fmt.Errorf("value is not a keyring reference")
+	}
+
+	err := keyring.Delete(service, strings.TrimPrefix(reference, prefix))
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("cannot delete secret from the system keyring: %w", err)
+	}
+
+	return nil
+}
+
+// MockInit replaces the system keyring with an in-memory one, it is meant for tests
+func MockInit() {
+	keyring.MockInit()
+}
@@ -0,0 +1,46 @@
+package keyring_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/keyring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreResolve(t *testing.T) {
+	keyring.MockInit()
+	secretKey := "11111111-1111-1111-1111-111111111111"
+
+	reference, err := keyring.Store("p1", secretKey)
+	require.NoError(t, err)
+	assert.True(t, keyring.IsReference(reference))
+	assert.NotContains(t, reference, secretKey)
+
+	resolved, err := keyring.Resolve(reference)
+	require.NoError(t, err)
+	assert.Equal(t, secretKey, resolved)
+}
+
+func TestResolveMissing(t *testing.T) {
+	keyring.MockInit()
+
+	_, err := keyring.Resolve("keyring:unknown")
+	assert.ErrorIs(t, err, keyring.ErrNotFound)
+
+	_, err = keyring.Resolve("11111111-1111-1111-1111-111111111111")
+	assert.Error(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	keyring.MockInit()
+
+	reference, err := keyring.Store("p1", "11111111-1111-1111-1111-111111111111")
+	require.NoError(t, err)
+	require.NoError(t, keyring.Delete(reference))
+
+	_, err = keyring.Resolve(reference)
+	assert.ErrorIs(t, err, keyring.ErrNotFound)
+
+	assert.NoError(t, keyring.Delete(reference))
+}
@@ -72,6 +72,10 @@ func aliasCreateCommand() *core.Command {
 				Short: "Add an alias to a verb",
 				Raw:   `scw alias create c command=create`,
 			},
+			{
+				Short: "Create an alias 'psl' listing the running servers, flags are kept in the expanded command",
+				Raw:   `scw alias create psl command="instance server list --filter state=running"`,
+			},
 		},
 		AllowAnonymousClient: true,
 		ArgSpecs: core.ArgSpecs{
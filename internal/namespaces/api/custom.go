@@ -0,0 +1,187 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func GetCommands() *core.Commands {
+	return core.NewCommands(
+		apiRoot(),
+		apiRequestCommand(http.MethodGet),
+		apiRequestCommand(http.MethodPost),
+		apiRequestCommand(http.MethodPut),
+		apiRequestCommand(http.MethodPatch),
+		apiRequestCommand(http.MethodDelete),
+	)
+}
+
+func apiRoot() *core.Command {
+	return &core.Command{
+		Groups: []string{"utility"},
+		Short:  `Send raw requests to the Scaleway API`,
+		Long: `Send raw requests to the Scaleway API, signed with the credentials of the current profile.
+This is useful to call API endpoints that are not yet available as CLI commands.`,
+		Namespace: "api",
+		ArgsType:  reflect.TypeOf(struct{}{}),
+		ArgSpecs:  core.ArgSpecs{},
+	}
+}
+
+type apiRequestArgs struct {
+	Path  string
+	Query map[string]string
+	Body  string
+}
+
+func apiRequestCommand(method string) *core.Command {
+	argSpecs := core.ArgSpecs{
+		{
+			Name:       "path",
+			Short:      "Path of the API endpoint, starting with a slash",
+			Required:   true,
+			Positional: true,
+		},
+		{
+			Name:  "query.{key}",
+			Short: "Query parameters of the request",
+		},
+	}
+	if method != http.MethodGet && method != http.MethodDelete {
+		argSpecs = append(argSpecs, &core.ArgSpec{
+			Name:        "body",
+			Short:       "JSON body of the request, use @path/to/file.json to read it from a file",
+			CanLoadFile: true,
+		})
+	}
+
+	return &core.Command{
+		Groups:    []string{"utility"},
+		Short:     fmt.Sprintf("Send a %s request to the Scaleway API", method),
+		Long:      fmt.Sprintf("Send a %s request to the given path of the Scaleway API and print the raw response.", method),
+		Namespace: "api",
+		Resource:  strings.ToLower(method),
+		ArgsType:  reflect.TypeOf(apiRequestArgs{}),
+		ArgSpecs:  argSpecs,
+		Examples:  apiRequestExamples(method),
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			args := argsI.(*apiRequestArgs)
+
+			request, err := newScalewayRequest(method, args.Path, args.Query, args.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			response := apiResponse{}
+			err = core.ExtractClient(ctx).Do(request, &response, scw.WithContext(ctx))
+			if err != nil {
+				return nil, err
+			}
+			if len(response) == 0 {
+				return &core.SuccessResult{Empty: true}, nil
+			}
+			return response, nil
+		},
+	}
+}
+
+func apiRequestExamples(method string) []*core.Example {
+	switch method {
+	case http.MethodGet:
+		return []*core.Example{
+			{
+				Short: "List the Instances of the fr-par-1 zone",
+				Raw:   "scw api get /instance/v1/zones/fr-par-1/servers",
+			},
+			{
+				Short: "List the running Instances of the fr-par-1 zone",
+				Raw:   "scw api get /instance/v1/zones/fr-par-1/servers query.state=running",
+			},
+		}
+	case http.MethodPost:
+		return []*core.Example{
+			{
+				Short: "Create a Private Network with a body read from a file",
+				Raw:   "scw api post /vpc/v2/regions/fr-par/private-networks body=@private-network.json",
+			},
+		}
+	}
+	return nil
+}
+
+// newScalewayRequest builds the request sent to the API.
+func newScalewayRequest(method string, path string, query map[string]string, body string) (*scw.ScalewayRequest, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("invalid path %s", path),
+			Hint: "The path must start with a slash, e.g. /instance/v1/zones/fr-par-1/servers",
+		}
+	}
+	if strings.Contains(path, "?") {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("invalid path %s", path),
+			Hint: "Pass query parameters as arguments, e.g. query.state=running",
+		}
+	}
+
+	request := &scw.ScalewayRequest{
+		Method:  method,
+		Path:    path,
+		Headers: http.Header{},
+		Query:   url.Values{},
+	}
+	for key, value := range query {
+		request.Query.Set(key, value)
+	}
+
+	if body != "" {
+		if !json.Valid([]byte(body)) {
+			return nil, fmt.Errorf("body is not valid JSON")
+		}
+		request.Headers.Set("Content-Type", "application/json")
+		request.Body = bytes.NewBufferString(body)
+	}
+
+	return request, nil
+}
+
+// apiResponse is the raw body of an API response.
+// The SDK decodes JSON responses with UnmarshalJSON and copies other responses with Write.
+type apiResponse []byte
+
+func (r *apiResponse) UnmarshalJSON(b []byte) error {
+	*r = append((*r)[:0], b...)
+	return nil
+}
+
+func (r *apiResponse) Write(p []byte) (int, error) {
+	*r = append(*r, p...)
+	return len(p), nil
+}
+
+func (r apiResponse) MarshalJSON() ([]byte, error) {
+	if json.Valid(r) {
+		return r, nil
+	}
+	return json.Marshal(string(r))
+}
+
+func (r apiResponse) MarshalHuman() (string, error) {
+	if !json.Valid(r) {
+		return string(r), nil
+	}
+	buf := &bytes.Buffer{}
+	if err := json.Indent(buf, r, "", "  "); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,73 @@
+package api_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/api"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_APIRequest(t *testing.T) {
+	// The test server echoes the request it receives
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if len(body) == 0 {
+			body = []byte("null")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"method":%q,"path":%q,"state":%q,"body":%s}`, r.Method, r.URL.Path, r.URL.Query().Get("state"), body)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := scw.NewClient(
+		scw.WithAPIURL(server.URL),
+		scw.WithAuth("SCWXXXXXXXXXXXXXXXXX", "11111111-1111-1111-1111-111111111111"),
+	)
+	require.NoError(t, err)
+
+	t.Run("Get with query", core.Test(&core.TestConfig{
+		Commands: api.GetCommands(),
+		Client:   client,
+		Cmd:      "scw api get /instance/v1/zones/fr-par-1/servers query.state=running",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stdout), `"method": "GET"`)
+				assert.Contains(t, string(ctx.Stdout), `"path": "/instance/v1/zones/fr-par-1/servers"`)
+				assert.Contains(t, string(ctx.Stdout), `"state": "running"`)
+			},
+		),
+	}))
+
+	t.Run("Post with body", core.Test(&core.TestConfig{
+		Commands: api.GetCommands(),
+		Client:   client,
+		Cmd:      `scw api post /vpc/v2/regions/fr-par/private-networks body={"name":"pn"}`,
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stdout), `"method": "POST"`)
+				assert.Contains(t, string(ctx.Stdout), `"name": "pn"`)
+			},
+		),
+	}))
+
+	t.Run("Invalid path", core.Test(&core.TestConfig{
+		Commands: api.GetCommands(),
+		Client:   client,
+		Cmd:      "scw api get instance/v1/zones/fr-par-1/servers",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stderr), "must start with a slash")
+			},
+		),
+	}))
+}
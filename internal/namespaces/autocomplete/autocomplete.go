@@ -25,7 +25,10 @@ func GetCommands() *core.Commands {
 		autocompleteCompleteBashCommand(),
 		autocompleteCompleteFishCommand(),
 		autocompleteCompleteZshCommand(),
+		autocompleteCompletePowershellCommand(),
 		autocompleteScriptCommand(),
+		autocompleteCacheCommand(),
+		autocompleteCacheClearCommand(),
 	)
 
 	for _, cmd := range cmds.GetAll() {
@@ -130,6 +133,25 @@ func autocompleteScripts(ctx context.Context, basename string) map[string]autoco
 				"linux":  path.Join(homePath, ".zshrc"),
 			},
 		},
+		"powershell": {
+			// $wordToComplete is the word being completed, the words before it are sent
+			// after their count as PowerShell drops empty arguments passed to native commands.
+			CompleteFunc: fmt.Sprintf(`
+			Register-ArgumentCompleter -Native -CommandName %[1]s -ScriptBlock {
+				param($wordToComplete, $commandAst, $cursorPosition)
+				$leftWords = @($commandAst.CommandElements | Where-Object { $_.Extent.EndOffset -lt $cursorPosition } | ForEach-Object { $_.Extent.Text })
+				%[1]s autocomplete complete powershell '--' $leftWords.Count @leftWords $wordToComplete | ForEach-Object {
+					[System.Management.Automation.CompletionResult]::new($_, $_, 'ParameterValue', $_)
+				}
+			}
+		`, basename),
+			CompleteScript: fmt.Sprintf(`%s autocomplete script shell=powershell | Out-String | Invoke-Expression`, basename),
+			ShellConfigurationFile: map[string]string{
+				"darwin":  path.Join(homePath, ".config/powershell/Microsoft.PowerShell_profile.ps1"),
+				"linux":   path.Join(homePath, ".config/powershell/Microsoft.PowerShell_profile.ps1"),
+				"windows": filepath.Join(homePath, "Documents", "PowerShell", "Microsoft.PowerShell_profile.ps1"),
+			},
+		},
 	}
 }
 
@@ -171,8 +193,8 @@ func InstallCommandRun(ctx context.Context, argsI interface{}) (i interface{}, e
 	logger.Debugf("shellArg: %v", shellArg)
 	if shellArg == "" {
 		defaultShellName := "bash"
-		if os.Getenv("SHELL") != "" {
-			defaultShellName = filepath.Base(os.Getenv("SHELL"))
+		if shellEnv := core.ExtractEnv(ctx, "SHELL"); shellEnv != "" {
+			defaultShellName = filepath.Base(shellEnv)
 		}
 
 		promptedShell, err := interactive.PromptStringWithConfig(&interactive.PromptStringConfig{
@@ -386,9 +408,53 @@ func autocompleteCompleteZshCommand() *core.Command {
 	}
 }
 
+func autocompleteCompletePowershellCommand() *core.Command {
+	return &core.Command{
+		Short:     `Autocomplete for PowerShell`,
+		Long:      `Autocomplete for PowerShell.`,
+		Namespace: "autocomplete",
+		Resource:  "complete",
+		Verb:      "powershell",
+		// TODO: Switch AllowAnonymousClient to true when cache will be implemented.
+		AllowAnonymousClient: false,
+		Hidden:               true,
+		DisableTelemetry:     true,
+		ArgsType:             reflect.TypeOf(args.RawArgs{}),
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			rawArgs := *argsI.(*args.RawArgs)
+			if len(rawArgs) < 2 {
+				return nil, fmt.Errorf("not enough arguments")
+			}
+
+			// First arg is the number of words before the word to complete.
+			leftWordsCount, err := strconv.Atoi(rawArgs[0])
+			if err != nil {
+				return nil, err
+			}
+			words := rawArgs[1:]
+			if leftWordsCount <= 0 || len(words) < leftWordsCount {
+				return nil, fmt.Errorf("number of words is invalid")
+			}
+
+			aliases := core.ExtractAliases(ctx)
+
+			leftWords := aliases.ResolveAliases(words[:leftWordsCount])
+			// The word to complete is missing when it is empty.
+			wordToComplete := ""
+			if len(words) > leftWordsCount {
+				wordToComplete = words[leftWordsCount]
+			}
+
+			res := core.AutoComplete(ctx, leftWords, wordToComplete, nil)
+			return strings.Join(res.Suggestions, "\n"), nil
+		},
+	}
+}
+
 type autocompleteShowArgs struct {
 	Shell    string
 	Basename string
+	Static   bool
 }
 
 func autocompleteScriptCommand() *core.Command {
@@ -411,11 +477,18 @@ func autocompleteScriptCommand() *core.Command {
 					return resp, resp
 				},
 			},
+			{
+				Name:  "static",
+				Short: "Generate a script completing commands and arguments of this version without running scw on each completion",
+			},
 		},
 		ArgsType: reflect.TypeOf(autocompleteShowArgs{}),
 		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
 			shell := filepath.Base(argsI.(*autocompleteShowArgs).Shell)
 			basename := argsI.(*autocompleteShowArgs).Basename
+			if argsI.(*autocompleteShowArgs).Static {
+				return staticScript(ctx, shell, basename)
+			}
 			script, exists := autocompleteScripts(ctx, basename)[shell]
 			if !exists {
 				return nil, unsupportedShellError(shell)
@@ -425,6 +498,37 @@ func autocompleteScriptCommand() *core.Command {
 	}
 }
 
+func autocompleteCacheCommand() *core.Command {
+	return &core.Command{
+		Short:     `Autocomplete cache related commands`,
+		Long:      `Resources listed from the API to complete arguments are cached for a minute, per profile and namespace.`,
+		Namespace: "autocomplete",
+		Resource:  "cache",
+	}
+}
+
+func autocompleteCacheClearCommand() *core.Command {
+	return &core.Command{
+		Short:                `Clear the autocomplete cache`,
+		Long:                 `Clear the resources cached to complete arguments, for every profile.`,
+		Namespace:            "autocomplete",
+		Resource:             "cache",
+		Verb:                 "clear",
+		AllowAnonymousClient: true,
+		ArgsType:             reflect.TypeOf(struct{}{}),
+		ArgSpecs:             core.ArgSpecs{},
+		Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+			err := core.ClearAutocompleteCache(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &core.SuccessResult{
+				Message: "successfully clear autocomplete cache",
+			}, nil
+		},
+	}
+}
+
 func TrimText(str string) string {
 	foundFirstNonEmptyLine := false
 	strToRemove := ""
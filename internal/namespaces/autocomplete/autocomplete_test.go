@@ -1,9 +1,13 @@
 package autocomplete_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/autocomplete"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -76,3 +80,59 @@ func TestTrimText(t *testing.T) {
 complete -F _scw scw`,
 	}))
 }
+
+func Test_AutocompletePowershell(t *testing.T) {
+	t.Run("Script", core.Test(&core.TestConfig{
+		Commands: autocomplete.GetCommands(),
+		Cmd:      "scw autocomplete script shell=powershell basename=scw",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("Complete command", core.Test(&core.TestConfig{
+		Commands: autocomplete.GetCommands(),
+		Cmd:      "scw autocomplete complete powershell -- 2 scw autocomplete scr",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, "script", ctx.Result)
+			},
+		),
+	}))
+
+	t.Run("Complete argument", core.Test(&core.TestConfig{
+		Commands: autocomplete.GetCommands(),
+		Cmd:      "scw autocomplete complete powershell -- 3 scw autocomplete script sh",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Equal(t, "shell=", ctx.Result)
+			},
+		),
+	}))
+}
+
+func Test_AutocompleteCacheClear(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands:   autocomplete.GetCommands(),
+		TmpHomeDir: true,
+		BeforeFunc: func(ctx *core.BeforeFuncCtx) error {
+			cachedValues := filepath.Join(ctx.OverrideEnv[scw.ScwCacheDirEnv], "autocomplete", "default", "instance", "values.json")
+			if err := os.MkdirAll(filepath.Dir(cachedValues), 0700); err != nil {
+				return err
+			}
+			return os.WriteFile(cachedValues, []byte("{}"), 0600)
+		},
+		Cmd: "scw autocomplete cache clear",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				_, err := os.Stat(filepath.Join(ctx.OverrideEnv[scw.ScwCacheDirEnv], "autocomplete"))
+				assert.True(t, os.IsNotExist(err))
+			},
+		),
+	}))
+}
@@ -0,0 +1,358 @@
+package autocomplete
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+// staticScript returns a completion script for shell built from the commands of this version.
+// Unlike the scripts running scw on each completion, it cannot complete argument values fetched from the API.
+func staticScript(ctx context.Context, shell string, basename string) (string, error) {
+	tree := buildCommandTree(core.ExtractCommands(ctx))
+	switch shell {
+	case "bash":
+		return staticBashScript(basename, tree), nil
+	case "zsh":
+		return staticZshScript(basename, tree), nil
+	case "fish":
+		return staticFishScript(basename, tree), nil
+	case "powershell":
+		return staticPowershellScript(basename, tree), nil
+	default:
+		return "", unsupportedShellError(shell)
+	}
+}
+
+// commandNode is a namespace, a resource or a verb of the command tree completed by static scripts.
+type commandNode struct {
+	// Path is the words leading to the node, e.g. "instance server list".
+	Path string
+	// Children are the words that can follow the path, sorted.
+	Children []string
+	// Short is the documentation of the path.
+	Short string
+	// Command is the command run by the path, nil if the path only groups other commands.
+	Command *core.Command
+}
+
+// buildCommandTree returns the nodes of the visible commands, sorted by path.
+func buildCommandTree(commands *core.Commands) []*commandNode {
+	nodes := map[string]*commandNode{
+		"": {Path: ""},
+	}
+	children := map[string]map[string]bool{}
+
+	for _, cmd := range commands.GetAll() {
+		if cmd.Hidden {
+			continue
+		}
+		words := []string(nil)
+		for _, word := range []string{cmd.Namespace, cmd.Resource, cmd.Verb} {
+			if word != "" {
+				words = append(words, word)
+			}
+		}
+
+		for i := range words {
+			parent := strings.Join(words[:i], " ")
+			path := strings.Join(words[:i+1], " ")
+			if children[parent] == nil {
+				children[parent] = map[string]bool{}
+			}
+			children[parent][words[i]] = true
+			if nodes[path] == nil {
+				nodes[path] = &commandNode{Path: path}
+			}
+		}
+		node := nodes[strings.Join(words, " ")]
+		node.Short = cmd.Short
+		if cmd.Run != nil {
+			node.Command = cmd
+		}
+	}
+
+	tree := make([]*commandNode, 0, len(nodes))
+	for path, node := range nodes {
+		for child := range children[path] {
+			node.Children = append(node.Children, child)
+		}
+		sort.Strings(node.Children)
+		tree = append(tree, node)
+	}
+	sort.Slice(tree, func(i, j int) bool {
+		return tree[i].Path < tree[j].Path
+	})
+	return tree
+}
+
+// completedArgSpecs returns the arguments of a command that can be completed, with their name completed by static scripts.
+// Positional and deprecated arguments are not completed, the first element of lists is suggested.
+func completedArgSpecs(cmd *core.Command) map[string]*core.ArgSpec {
+	argSpecs := map[string]*core.ArgSpec{}
+	if cmd == nil {
+		return argSpecs
+	}
+	for _, argSpec := range cmd.ArgSpecs {
+		if argSpec.Positional || argSpec.Deprecated || strings.Contains(argSpec.Name, "{key}") {
+			continue
+		}
+		argSpecs[strings.ReplaceAll(argSpec.Name, "{index}", "0")] = argSpec
+	}
+	return argSpecs
+}
+
+// completedArgNames returns the sorted names of the completed arguments of a command, followed by =.
+func completedArgNames(cmd *core.Command) []string {
+	names := []string(nil)
+	for name := range completedArgSpecs(cmd) {
+		names = append(names, name+"=")
+	}
+	sort.Strings(names)
+	return names
+}
+
+// staticBashScript returns a bash completion script for the command tree.
+// The words before the cursor which are commands make up the command path,
+// the suggestions are the words following this path and the arguments of its command.
+func staticBashScript(basename string, tree []*commandNode) string {
+	paths := make([]string, 0, len(tree))
+	cases := &strings.Builder{}
+	for _, node := range tree {
+		if node.Path != "" {
+			paths = append(paths, node.Path)
+		}
+		suggestions := append(append([]string(nil), node.Children...), completedArgNames(node.Command)...)
+		if len(suggestions) == 0 {
+			continue
+		}
+		_, _ = fmt.Fprintf(cases, "\t\t\"%s\") suggestions=\"%s\" ;;\n", node.Path, strings.Join(suggestions, " "))
+	}
+
+	return fmt.Sprintf(`_%[1]s() {
+	local cur words cword
+	_get_comp_words_by_ref -n = cur words cword
+
+	local command_paths="|%[2]s|"
+	local command_path="" next_path i
+	for ((i = 1; i < cword; i++)); do
+		next_path="${command_path:+$command_path }${words[i]}"
+		if [[ $command_paths == *"|$next_path|"* ]]; then
+			command_path="$next_path"
+		fi
+	done
+
+	local suggestions=""
+	case "$command_path" in
+%[3]s	esac
+
+	COMPREPLY=($(compgen -W "$suggestions" -- "$cur"))
+	# apply compopt option and ignore failure for older bash versions
+	[[ $COMPREPLY == *= ]] && compopt -o nospace 2> /dev/null || true
+}
+complete -F _%[1]s %[1]s`, basename, strings.Join(paths, "|"), cases.String())
+}
+
+// staticZshScript returns a zsh completion script for the command tree.
+// Commands and arguments are described with their short documentation.
+func staticZshScript(basename string, tree []*commandNode) string {
+	shorts := map[string]string{}
+	paths := &strings.Builder{}
+	for _, node := range tree {
+		shorts[node.Path] = node.Short
+		if node.Path != "" {
+			_, _ = fmt.Fprintf(paths, "\t\t%s\n", zshQuote(node.Path))
+		}
+	}
+
+	cases := &strings.Builder{}
+	for _, node := range tree {
+		commands := []string(nil)
+		for _, child := range node.Children {
+			childPath := strings.TrimPrefix(node.Path+" "+child, " ")
+			commands = append(commands, zshQuote(zshDescription(child, shorts[childPath])))
+		}
+		argSpecs := completedArgSpecs(node.Command)
+		args := []string(nil)
+		for _, name := range completedArgNames(node.Command) {
+			args = append(args, zshQuote(zshDescription(name, argSpecs[strings.TrimSuffix(name, "=")].Short)))
+		}
+		if len(commands) == 0 && len(args) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(cases, "\t\t%s)\n", zshQuote(node.Path))
+		if len(commands) > 0 {
+			_, _ = fmt.Fprintf(cases, "\t\t\tcommands=(%s)\n", strings.Join(commands, " "))
+		}
+		if len(args) > 0 {
+			_, _ = fmt.Fprintf(cases, "\t\t\targs=(%s)\n", strings.Join(args, " "))
+		}
+		cases.WriteString("\t\t\t;;\n")
+	}
+
+	return fmt.Sprintf(`autoload -U compinit && compinit
+_%[1]s() {
+	local -a command_paths=(
+%[2]s	)
+	local command_path="" next_path word
+	for word in ${words[2,CURRENT-1]}; do
+		next_path="${command_path:+$command_path }$word"
+		if (( ${command_paths[(Ie)$next_path]} )); then
+			command_path="$next_path"
+		fi
+	done
+
+	local -a commands args
+	case "$command_path" in
+%[3]s	esac
+
+	_describe -t commands 'command' commands
+	_describe -t arguments 'argument' args -S ''
+}
+compdef _%[1]s %[1]s`, basename, paths.String(), cases.String())
+}
+
+// zshDescription returns a completion with its description in the format of _describe.
+func zshDescription(completion string, description string) string {
+	completion = strings.ReplaceAll(completion, ":", `\:`)
+	description = strings.Join(strings.Fields(description), " ")
+	if description == "" {
+		return completion
+	}
+	return completion + ":" + description
+}
+
+// zshQuote quotes a string for zsh.
+func zshQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// staticFishScript returns a fish completion script for the command tree.
+// Arguments with a set of values are completed with each of their values.
+func staticFishScript(basename string, tree []*commandNode) string {
+	shorts := map[string]string{}
+	paths := []string(nil)
+	for _, node := range tree {
+		shorts[node.Path] = node.Short
+		if node.Path != "" {
+			paths = append(paths, fishQuote(node.Path))
+		}
+	}
+
+	completions := &strings.Builder{}
+	complete := func(path string, completion string, description string) {
+		_, _ = fmt.Fprintf(completions, "complete --command %s --condition %s --arguments %s",
+			basename, fishQuote(fmt.Sprintf("__%s_command_path_is %s", basename, fishQuote(path))), fishQuote(completion))
+		if description = strings.Join(strings.Fields(description), " "); description != "" {
+			_, _ = fmt.Fprintf(completions, " --description %s", fishQuote(description))
+		}
+		completions.WriteString("\n")
+	}
+	for _, node := range tree {
+		for _, child := range node.Children {
+			complete(node.Path, child, shorts[strings.TrimPrefix(node.Path+" "+child, " ")])
+		}
+		argSpecs := completedArgSpecs(node.Command)
+		for _, name := range completedArgNames(node.Command) {
+			argSpec := argSpecs[strings.TrimSuffix(name, "=")]
+			if len(argSpec.EnumValues) == 0 {
+				complete(node.Path, name, argSpec.Short)
+				continue
+			}
+			for _, value := range argSpec.EnumValues {
+				complete(node.Path, name+value, argSpec.Short)
+			}
+		}
+	}
+
+	return fmt.Sprintf(`function __%[1]s_command_path
+	set -l command_paths %[2]s
+	set -l command_path ''
+	for word in (commandline -opc)[2..-1]
+		set -l next_path $word
+		if test -n "$command_path"
+			set next_path "$command_path $word"
+		end
+		if contains -- $next_path $command_paths
+			set command_path $next_path
+		end
+	end
+	echo $command_path
+end
+
+function __%[1]s_command_path_is
+	set -l command_path (__%[1]s_command_path)
+	test "$command_path" = "$argv[1]"
+end
+
+complete --erase --command %[1]s
+complete --command %[1]s --no-files
+%[3]s`, basename, strings.Join(paths, " "), strings.TrimSuffix(completions.String(), "\n"))
+}
+
+// fishQuote quotes a string for fish.
+func fishQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return "'" + strings.ReplaceAll(s, "'", `\'`) + "'"
+}
+
+// staticPowershellScript returns a PowerShell completion script for the command tree.
+// Commands and arguments are described with their short documentation in tooltips.
+func staticPowershellScript(basename string, tree []*commandNode) string {
+	shorts := map[string]string{}
+	for _, node := range tree {
+		shorts[node.Path] = node.Short
+	}
+
+	completions := &strings.Builder{}
+	for _, node := range tree {
+		entries := []string(nil)
+		for _, child := range node.Children {
+			childPath := strings.TrimPrefix(node.Path+" "+child, " ")
+			entries = append(entries, powershellEntry(child, shorts[childPath]))
+		}
+		argSpecs := completedArgSpecs(node.Command)
+		for _, name := range completedArgNames(node.Command) {
+			entries = append(entries, powershellEntry(name, argSpecs[strings.TrimSuffix(name, "=")].Short))
+		}
+		_, _ = fmt.Fprintf(completions, "\t\t%s = [ordered]@{%s}\n", powershellQuote(node.Path), strings.Join(entries, "; "))
+	}
+
+	return fmt.Sprintf(`Register-ArgumentCompleter -Native -CommandName %[1]s -ScriptBlock {
+	param($wordToComplete, $commandAst, $cursorPosition)
+	$completions = @{
+%[2]s	}
+
+	$commandPath = ''
+	$words = @($commandAst.CommandElements | Where-Object { $_.Extent.EndOffset -lt $cursorPosition } | Select-Object -Skip 1)
+	foreach ($word in $words) {
+		$nextPath = "$commandPath $($word.Extent.Text)".Trim()
+		if ($completions.Contains($nextPath)) {
+			$commandPath = $nextPath
+		}
+	}
+
+	$completions[$commandPath].GetEnumerator() | Where-Object { $_.Key.StartsWith($wordToComplete) } | ForEach-Object {
+		[System.Management.Automation.CompletionResult]::new($_.Key, $_.Key, 'ParameterValue', $_.Value)
+	}
+}`, basename, completions.String())
+}
+
+// powershellEntry returns a completion with its tooltip as an entry of a PowerShell hashtable.
+// PowerShell does not accept empty tooltips, the completion is used instead.
+func powershellEntry(completion string, description string) string {
+	description = strings.Join(strings.Fields(description), " ")
+	if description == "" {
+		description = completion
+	}
+	return powershellQuote(completion) + " = " + powershellQuote(description)
+}
+
+// powershellQuote quotes a string for PowerShell.
+func powershellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,78 @@
+package autocomplete_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/autocomplete"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func Test_StaticScript(t *testing.T) {
+	t.Run("Bash", core.Test(&core.TestConfig{
+		Commands: autocomplete.GetCommands(),
+		Cmd:      "scw autocomplete script shell=bash basename=scw static=true",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("Zsh", core.Test(&core.TestConfig{
+		Commands: autocomplete.GetCommands(),
+		Cmd:      "scw autocomplete script shell=zsh basename=scw static=true",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("Fish", core.Test(&core.TestConfig{
+		Commands: autocomplete.GetCommands(),
+		Cmd:      "scw autocomplete script shell=fish basename=scw static=true",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("Powershell", core.Test(&core.TestConfig{
+		Commands: autocomplete.GetCommands(),
+		Cmd:      "scw autocomplete script shell=powershell basename=scw static=true",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+	}))
+
+	t.Run("Fish with enum values", func(t *testing.T) {
+		commands := autocomplete.GetCommands()
+		commands.Merge(core.NewCommands(&core.Command{
+			Namespace: "test",
+			Resource:  "server",
+			Verb:      "list",
+			ArgsType:  reflect.TypeOf(struct{ Zone scw.Zone }{}),
+			ArgSpecs: core.ArgSpecs{
+				core.ZoneArgSpec(scw.ZoneFrPar1, scw.ZoneNlAms1),
+			},
+			Run: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, nil
+			},
+		}))
+		core.Test(&core.TestConfig{
+			Commands: commands,
+			Cmd:      "scw autocomplete script shell=fish basename=scw static=true",
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(0),
+				func(t *testing.T, ctx *core.CheckFuncCtx) {
+					assert.Contains(t, string(ctx.Stdout), "--condition '__scw_command_path_is \\'test server list\\'' --arguments 'zone=fr-par-1'")
+					assert.Contains(t, string(ctx.Stdout), "--arguments 'zone=nl-ams-1'")
+					assert.NotContains(t, string(ctx.Stdout), "--arguments 'zone='")
+				},
+			),
+		})(t)
+	})
+}
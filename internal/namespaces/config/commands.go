@@ -3,16 +3,24 @@ package config
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/scaleway/scaleway-sdk-go/validation"
 
 	"github.com/fatih/color"
+	"github.com/scaleway/scaleway-cli/v2/internal/account"
+	cliConfig "github.com/scaleway/scaleway-cli/v2/internal/config"
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
 	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+	"github.com/scaleway/scaleway-cli/v2/internal/keyring"
+	"github.com/scaleway/scaleway-cli/v2/internal/passphrase"
+	"github.com/scaleway/scaleway-cli/v2/internal/redact"
 	"github.com/scaleway/scaleway-cli/v2/internal/tabwriter"
 	"github.com/scaleway/scaleway-cli/v2/internal/terminal"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -27,6 +35,8 @@ func GetCommands() *core.Commands {
 		configUnsetCommand(),
 		configDumpCommand(),
 		configProfileCommand(),
+		configCreateProfileCommand(),
+		configListProfileCommand(),
 		configDeleteProfileCommand(),
 		configActivateProfileCommand(),
 		configResetCommand(),
@@ -34,6 +44,8 @@ func GetCommands() *core.Commands {
 		configInfoCommand(),
 		configImportCommand(),
 		configValidateCommand(),
+		configExplainAuthCommand(),
+		configPruneCommand(),
 	)
 }
 
@@ -120,7 +132,7 @@ func configGetCommand() *core.Command {
 				Name:       "key",
 				Short:      "the key to get from the config",
 				Required:   true,
-				EnumValues: getProfileKeys(),
+				EnumValues: append(getProfileKeys(), cliConfigKeys...),
 				Positional: true,
 			},
 		},
@@ -141,11 +153,15 @@ func configGetCommand() *core.Command {
 			},
 		},
 		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			key := argsI.(*configGetArgs).Key
+			if field, ok := getCliConfigField(core.ExtractCliConfig(ctx), key); ok {
+				return field.Interface(), nil
+			}
+
 			config, err := scw.LoadConfigFromPath(core.ExtractConfigPath(ctx))
 			if err != nil {
 				return nil, err
 			}
-			key := argsI.(*configGetArgs).Key
 
 			profileName := core.ExtractProfileName(ctx)
 			profile, err := getProfile(config, profileName)
@@ -160,6 +176,12 @@ func configGetCommand() *core.Command {
 
 // configSetCommand sets a value for the scaleway config
 func configSetCommand() *core.Command {
+	type configSetArgs struct {
+		scw.Profile
+		SendUsage        *bool
+		SendCrashReports *bool
+	}
+
 	allRegions := []string(nil)
 	for _, region := range scw.AllRegions {
 		allRegions = append(allRegions, region.String())
@@ -173,11 +195,12 @@ func configSetCommand() *core.Command {
 		Groups: []string{"config"},
 		Short:  `Set a line from the config file`,
 		Long: `This commands overwrites the configuration file parameters with user input.
-The only allowed attributes are access_key, secret_key, default_organization_id, default_region, default_zone, api_url, insecure`,
+The only allowed attributes are access_key, secret_key, default_organization_id, default_region, default_zone, api_url, insecure.
+send_usage and send_crash_reports are not bound to a profile, they are stored in the CLI config.`,
 		Namespace:            "config",
 		Resource:             "set",
 		AllowAnonymousClient: true,
-		ArgsType:             reflect.TypeOf(scw.Profile{}),
+		ArgsType:             reflect.TypeOf(configSetArgs{}),
 		ArgSpecs: core.ArgSpecs{
 			{
 				Name:  "access-key",
@@ -200,14 +223,9 @@ The only allowed attributes are access_key, secret_key, default_organization_id,
 				},
 			},
 			{
-				Name:  "api-url",
-				Short: "Scaleway API URL",
-				ValidateFunc: func(_ *core.ArgSpec, value interface{}) error {
-					if !reflect.ValueOf(value).IsNil() && !validation.IsURL(*value.(*string)) {
-						return fmt.Errorf("%s is not a valid URL", *value.(*string))
-					}
-					return nil
-				},
+				Name:         "api-url",
+				Short:        "Scaleway API URL",
+				ValidateFunc: core.ValidateAPIURL(),
 			},
 			{
 				Name:  "insecure",
@@ -246,6 +264,14 @@ The only allowed attributes are access_key, secret_key, default_organization_id,
 				Name:  "send-telemetry",
 				Short: "Set to false to disable telemetry",
 			},
+			{
+				Name:  "send-usage",
+				Short: "Set to false to disable usage statistics",
+			},
+			{
+				Name:  "send-crash-reports",
+				Short: "Set to false to disable crash reports",
+			},
 		},
 		Examples: []*core.Example{
 			{
@@ -256,6 +282,10 @@ The only allowed attributes are access_key, secret_key, default_organization_id,
 				Short: "Update the default region of the profile 'prod'",
 				Raw:   "scw -p prod config set default_region=nl-ams",
 			},
+			{
+				Short: "Disable usage statistics",
+				Raw:   "scw config set send-usage=false",
+			},
 		},
 		SeeAlsos: []*core.SeeAlso{
 			{
@@ -265,16 +295,49 @@ The only allowed attributes are access_key, secret_key, default_organization_id,
 		},
 		Run: func(ctx context.Context, argsI interface{}) (i interface{}, err error) {
 			// Validate arguments
-			args := argsI.(*scw.Profile)
+			args := argsI.(*configSetArgs)
+
+			// Settings that are not bound to a profile are stored in the CLI config,
+			// the profile config is only loaded when a profile setting is given
+			argValue := reflect.ValueOf(&args.Profile).Elem()
+			hasProfileSettings := false
+			for i := 0; i < argValue.NumField(); i++ {
+				if !argValue.Field(i).IsNil() {
+					hasProfileSettings = true
+					break
+				}
+			}
 
-			// Execute
+			// Both configs are loaded before saving any of them, so a missing profile config does not leave a partial update
 			configPath := core.ExtractConfigPath(ctx)
-			config, err := scw.LoadConfigFromPath(configPath)
-			if err != nil {
-				return nil, err
+			var config *scw.Config
+			if hasProfileSettings {
+				config, err = scw.LoadConfigFromPath(configPath)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if args.SendUsage != nil || args.SendCrashReports != nil {
+				cliCfg := core.ExtractCliConfig(ctx)
+				if args.SendUsage != nil {
+					cliCfg.SendUsage = args.SendUsage
+				}
+				if args.SendCrashReports != nil {
+					cliCfg.SendCrashReports = args.SendCrashReports
+				}
+				err = cliCfg.Save()
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if !hasProfileSettings {
+				return &core.SuccessResult{
+					Message: "successfully update config",
+				}, nil
 			}
 
-			// send_telemetry is the only key that is not in a profile but in the config object directly
 			profileName := core.ExtractProfileName(ctx)
 			profile := &config.Profile
 			if profileName != scw.DefaultProfileName {
@@ -289,7 +352,6 @@ The only allowed attributes are access_key, secret_key, default_organization_id,
 				}
 			}
 
-			argValue := reflect.ValueOf(args).Elem()
 			profileValue := reflect.ValueOf(profile).Elem()
 			for i := 0; i < argValue.NumField(); i++ {
 				field := argValue.Field(i)
@@ -329,17 +391,29 @@ func configUnsetCommand() *core.Command {
 				Name:       "key",
 				Short:      "the config config key name to unset",
 				Required:   true,
-				EnumValues: getProfileKeys(),
+				EnumValues: append(getProfileKeys(), cliConfigKeys...),
 				Positional: true,
 			},
 		},
 		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			key := argsI.(*configUnsetArgs).Key
+			cliCfg := core.ExtractCliConfig(ctx)
+			if field, ok := getCliConfigField(cliCfg, key); ok {
+				field.Set(reflect.Zero(field.Type()))
+				err := cliCfg.Save()
+				if err != nil {
+					return nil, err
+				}
+				return &core.SuccessResult{
+					Message: fmt.Sprintf("successfully unset %s", key),
+				}, nil
+			}
+
 			configPath := core.ExtractConfigPath(ctx)
 			config, err := scw.LoadConfigFromPath(configPath)
 			if err != nil {
 				return nil, err
 			}
-			key := argsI.(*configUnsetArgs).Key
 
 			profileName := core.ExtractProfileName(ctx)
 			profile, err := getProfile(config, profileName)
@@ -394,13 +468,210 @@ func configDumpCommand() *core.Command {
 func configProfileCommand() *core.Command {
 	return &core.Command{
 		Groups:               []string{"config"},
-		Short:                `Allows the activation and deletion of a profile from the config file`,
+		Short:                `Allows the creation, activation and deletion of a profile from the config file`,
 		Namespace:            "config",
 		Resource:             "profile",
 		AllowAnonymousClient: true,
 	}
 }
 
+// configCreateProfileCommand creates an empty profile in the config
+func configCreateProfileCommand() *core.Command {
+	allZones := []string(nil)
+	for _, zone := range scw.AllZones {
+		allZones = append(allZones, zone.String())
+	}
+
+	type configCreateProfileArgs struct {
+		Name     string
+		Zone     scw.Zone
+		Activate bool
+	}
+
+	return &core.Command{
+		Groups:               []string{"config"},
+		Short:                `Create a profile in the config file`,
+		Long:                 `Create a profile with only a default zone and region in the config file, its other attributes can then be filled with scw config set.`,
+		Namespace:            "config",
+		Resource:             "profile",
+		Verb:                 "create",
+		AllowAnonymousClient: true,
+		ArgsType:             reflect.TypeOf(configCreateProfileArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:       "name",
+				Required:   true,
+				Positional: true,
+			},
+			{
+				Name:       "zone",
+				Short:      "Default zone of the profile, its default region is deduced from it",
+				Default:    core.DefaultValueSetter(scw.ZoneFrPar1.String()),
+				EnumValues: allZones,
+			},
+			{
+				Name:  "activate",
+				Short: "Mark the new profile as active",
+			},
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Create the profile 'prod' and mark it as active",
+				Raw:   "scw config profile create prod activate=true",
+			},
+		},
+		SeeAlsos: []*core.SeeAlso{
+			{
+				Short:   "Create a profile interactively",
+				Command: "scw init profile=<name>",
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			args := argsI.(*configCreateProfileArgs)
+			configPath := core.ExtractConfigPath(ctx)
+			config, err := scw.LoadConfigFromPath(configPath)
+			if err != nil && !isConfigFileNotFoundError(err) {
+				return nil, err
+			}
+			if config == nil {
+				config = &scw.Config{}
+			}
+
+			if args.Name == scw.DefaultProfileName {
+				return nil, &core.CliError{
+					Err:  fmt.Errorf("%s is reserved for the default profile", args.Name),
+					Hint: "Choose another profile name.",
+				}
+			}
+			if _, exists := config.Profiles[args.Name]; exists {
+				return nil, &core.CliError{
+					Err:  fmt.Errorf("profile %s already exists", args.Name),
+					Hint: "Update it with scw -p " + args.Name + " config set.",
+				}
+			}
+
+			if config.Profiles == nil {
+				config.Profiles = map[string]*scw.Profile{}
+			}
+			region, err := args.Zone.Region()
+			if err != nil {
+				return nil, err
+			}
+			config.Profiles[args.Name] = &scw.Profile{
+				DefaultZone:   scw.StringPtr(args.Zone.String()),
+				DefaultRegion: scw.StringPtr(region.String()),
+			}
+			if args.Activate {
+				config.ActiveProfile = &args.Name
+			}
+
+			err = config.SaveTo(configPath)
+			if err != nil {
+				return nil, err
+			}
+
+			cliCfg := core.ExtractCliConfig(ctx)
+			if cliCfg.ProfilesUpdatedAt == nil {
+				cliCfg.ProfilesUpdatedAt = map[string]time.Time{}
+			}
+			cliCfg.ProfilesUpdatedAt[args.Name] = time.Now()
+			err = cliCfg.Save()
+			if err != nil {
+				return nil, err
+			}
+
+			return &core.SuccessResult{
+				Message: fmt.Sprintf("successfully create profile %s", args.Name),
+			}, nil
+		},
+	}
+}
+
+// configListProfileCommand lists the profiles of the config
+func configListProfileCommand() *core.Command {
+	type configListProfileArgs struct {
+		ResolveHere bool
+	}
+
+	type profileItem struct {
+		Name   string
+		Active bool
+	}
+
+	type resolvedProfileItem struct {
+		Name          string
+		Active        bool
+		EffectiveHere bool
+	}
+
+	return &core.Command{
+		Groups:               []string{"config"},
+		Short:                `List the profiles of the config file`,
+		Long:                 `List the profiles of the config file. The active profile is the one marked as active in the config file.`,
+		Namespace:            "config",
+		Resource:             "profile",
+		Verb:                 "list",
+		AllowAnonymousClient: true,
+		ArgsType:             reflect.TypeOf(configListProfileArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:  "resolve-here",
+				Short: "Show which profile is effective in the current directory once all overrides (flag, environment) are applied",
+			},
+		},
+		Examples: []*core.Example{
+			{
+				Short: "List all profiles",
+				Raw:   "scw config profile list",
+			},
+			{
+				Short: "Show which profile is used by commands run from here",
+				Raw:   "scw config profile list resolve-here=true",
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			args := argsI.(*configListProfileArgs)
+			config, err := scw.LoadConfigFromPath(core.ExtractConfigPath(ctx))
+			if err != nil {
+				return nil, err
+			}
+
+			activeProfileName := scw.DefaultProfileName
+			if config.ActiveProfile != nil {
+				activeProfileName = *config.ActiveProfile
+			}
+
+			profileNames := []string{scw.DefaultProfileName}
+			for name := range config.Profiles {
+				profileNames = append(profileNames, name)
+			}
+			sort.Strings(profileNames[1:])
+
+			if !args.ResolveHere {
+				items := make([]*profileItem, 0, len(profileNames))
+				for _, name := range profileNames {
+					items = append(items, &profileItem{
+						Name:   name,
+						Active: name == activeProfileName,
+					})
+				}
+				return items, nil
+			}
+
+			effectiveProfileName := core.ExtractProfileName(ctx)
+			items := make([]*resolvedProfileItem, 0, len(profileNames))
+			for _, name := range profileNames {
+				items = append(items, &resolvedProfileItem{
+					Name:          name,
+					Active:        name == activeProfileName,
+					EffectiveHere: name == effectiveProfileName,
+				})
+			}
+			return items, nil
+		},
+	}
+}
+
 // configDeleteProfileCommand deletes a profile from the config
 func configDeleteProfileCommand() *core.Command {
 	type configDeleteProfileArgs struct {
@@ -429,7 +700,8 @@ func configDeleteProfileCommand() *core.Command {
 			if err != nil {
 				return nil, err
 			}
-			if _, exists := config.Profiles[profileName]; exists {
+			profile, exists := config.Profiles[profileName]
+			if exists {
 				delete(config.Profiles, profileName)
 			} else {
 				return nil, unknownProfileError(profileName)
@@ -438,6 +710,10 @@ func configDeleteProfileCommand() *core.Command {
 			if err != nil {
 				return nil, err
 			}
+			err = deleteProfileSecret(profile)
+			if err != nil {
+				return nil, err
+			}
 
 			return &core.SuccessResult{
 				Message: fmt.Sprintf("successfully delete profile %s", profileName),
@@ -698,7 +974,9 @@ func configImportCommand() *core.Command {
 
 // configValidateCommand validates the config
 func configValidateCommand() *core.Command {
-	type configValidateArgs struct{}
+	type configValidateArgs struct {
+		Live bool
+	}
 
 	return &core.Command{
 		Short: `Validate the config`,
@@ -710,12 +988,31 @@ It performs the following checks:
 	- Field validity: It checks whether the fields present in the config file are valid and expected fields. This includes fields like AccessKey, SecretKey, DefaultOrganizationID, DefaultProjectID, DefaultRegion, DefaultZone, and APIURL.
 	- Field values: For each of the fields mentioned above, it checks whether the value assigned to it is valid. For example, it checks if the AccessKey and SecretKey are non-empty and meet the format expectations.
 
-The command goes through each profile present in the config file and validates it.`,
+The command goes through each profile present in the config file and validates it.
+
+With live=true, the credentials of the profile in use are also verified against the API: the secret key must exist,
+be bound to the access key and the default organization, and the default zone must belong to the default region.`,
 		Namespace:            "config",
 		Resource:             "validate",
 		AllowAnonymousClient: true,
 		ArgsType:             reflect.TypeOf(configValidateArgs{}),
-		Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:  "live",
+				Short: "Verify the credentials of the profile in use with an authenticated API call",
+			},
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Validate the credentials of the profile 'prod'",
+				Raw:   "scw -p prod config validate live=true",
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			if argsI.(*configValidateArgs).Live {
+				return validateLive(ctx)
+			}
+
 			configPath := core.ExtractConfigPath(ctx)
 			config, err := scw.LoadConfigFromPath(configPath)
 			if err != nil {
@@ -742,6 +1039,360 @@ The command goes through each profile present in the config file and validates i
 	}
 }
 
+// liveCheck is the result of one check done by config validate live=true
+type liveCheck struct {
+	Check   string
+	Valid   bool
+	Details string
+}
+
+// validateLive verifies the credentials of the client in use against the API.
+// It fails if any check fails, the checks are listed in the error details.
+func validateLive(ctx context.Context) ([]*liveCheck, error) {
+	client := core.ExtractClient(ctx)
+	accessKey, _ := client.GetAccessKey()
+	secretKey, _ := client.GetSecretKey()
+	organizationID, _ := client.GetDefaultOrganizationID()
+	region, _ := client.GetDefaultRegion()
+	zone, _ := client.GetDefaultZone()
+
+	if secretKey == "" {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("no secret key to validate"),
+			Hint: "Initialize the profile with scw init.",
+		}
+	}
+
+	checks := []*liveCheck(nil)
+	token, err := account.GetAPIKey(ctx, secretKey)
+	if err != nil {
+		checks = append(checks, &liveCheck{Check: "secret_key", Valid: false, Details: err.Error()})
+	} else {
+		checks = append(checks,
+			&liveCheck{Check: "secret_key", Valid: true},
+			&liveCheck{
+				Check:   "access_key",
+				Valid:   token.AccessKey == accessKey,
+				Details: fmt.Sprintf("secret key is bound to %s", token.AccessKey),
+			},
+			&liveCheck{
+				Check:   "default_organization_id",
+				Valid:   token.OrganizationID == organizationID,
+				Details: fmt.Sprintf("secret key is bound to %s", token.OrganizationID),
+			},
+		)
+	}
+
+	zoneRegion, err := zone.Region()
+	checks = append(checks, &liveCheck{
+		Check:   "default_zone",
+		Valid:   err == nil && zoneRegion == region,
+		Details: fmt.Sprintf("%s must belong to %s", zone, region),
+	})
+
+	invalid := []string(nil)
+	for _, check := range checks {
+		if !check.Valid {
+			invalid = append(invalid, fmt.Sprintf("check %s failed: %s", check.Check, check.Details))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, &core.CliError{
+			Err:     fmt.Errorf("invalid credentials for profile %s", core.ExtractProfileName(ctx)),
+			Details: strings.Join(invalid, "\n"),
+			Hint:    "Fix the profile with scw config set or scw init.",
+		}
+	}
+
+	return checks, nil
+}
+
+// configPruneCommand deletes profiles that have not been updated for a while
+func configPruneCommand() *core.Command {
+	type configPruneArgs struct {
+		Days uint32
+	}
+
+	type prunedProfile struct {
+		Name      string
+		UpdatedAt time.Time
+		Status    string
+	}
+
+	return &core.Command{
+		Groups: []string{"config"},
+		Short:  `Delete stale profiles from the config file`,
+		Long: `List the profiles that were not updated by scw init for the given number of days and offer to delete each of them.
+Profiles updated before this information was recorded are considered updated by the first prune.
+The active profile is never deleted.`,
+		Namespace:            "config",
+		Resource:             "prune",
+		AllowAnonymousClient: true,
+		ArgsType:             reflect.TypeOf(configPruneArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:    "days",
+				Short:   "Number of days after which a profile is stale",
+				Default: core.DefaultValueSetter("90"),
+			},
+		},
+		Examples: []*core.Example{
+			{
+				Short:    "Delete profiles not updated for a month",
+				ArgsJSON: `{"days": 30}`,
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			args := argsI.(*configPruneArgs)
+			configPath := core.ExtractConfigPath(ctx)
+			config, err := scw.LoadConfigFromPath(configPath)
+			if err != nil {
+				return nil, err
+			}
+			cliConfig := core.ExtractCliConfig(ctx)
+			// The profile in use is protected too, it may be selected with --profile or SCW_PROFILE
+			protectedProfiles := map[string]bool{
+				core.ExtractProfileName(ctx): true,
+			}
+			if config.ActiveProfile != nil {
+				protectedProfiles[*config.ActiveProfile] = true
+			}
+			staleBefore := time.Now().Add(-time.Duration(args.Days) * 24 * time.Hour)
+
+			names := []string(nil)
+			for name := range config.Profiles {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			results := []*prunedProfile(nil)
+			deletedProfiles := []*scw.Profile(nil)
+			for _, name := range names {
+				updatedAt, exists := cliConfig.ProfilesUpdatedAt[name]
+				if !exists {
+					// The profile is considered updated now, it will be offered by the prunes run in the given number of days
+					if cliConfig.ProfilesUpdatedAt == nil {
+						cliConfig.ProfilesUpdatedAt = map[string]time.Time{}
+					}
+					cliConfig.ProfilesUpdatedAt[name] = time.Now().UTC()
+					continue
+				}
+				if updatedAt.After(staleBefore) {
+					continue
+				}
+				result := &prunedProfile{
+					Name:      name,
+					UpdatedAt: updatedAt,
+				}
+
+				switch {
+				case protectedProfiles[name]:
+					result.Status = "kept, active profile"
+				default:
+					deleteProfile, err := interactive.PromptBoolWithConfig(&interactive.PromptBoolConfig{
+						Ctx:          ctx,
+						Prompt:       fmt.Sprintf("Profile %s has not been updated for %d days, do you want to delete it?", name, args.Days),
+						DefaultValue: false,
+					})
+					if err != nil {
+						return nil, err
+					}
+					if deleteProfile {
+						deletedProfiles = append(deletedProfiles, config.Profiles[name])
+						delete(config.Profiles, name)
+						delete(cliConfig.ProfilesUpdatedAt, name)
+						result.Status = "deleted"
+					} else {
+						result.Status = "kept"
+					}
+				}
+				results = append(results, result)
+			}
+
+			err = config.SaveTo(configPath)
+			if err != nil {
+				return nil, err
+			}
+			err = cliConfig.Save()
+			if err != nil {
+				return nil, err
+			}
+			for _, profile := range deletedProfiles {
+				err = deleteProfileSecret(profile)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return results, nil
+		},
+	}
+}
+
+// configExplainAuthCommand explains where the credentials used by the CLI come from
+func configExplainAuthCommand() *core.Command {
+	type configExplainAuthArgs struct{}
+
+	return &core.Command{
+		Groups: []string{"config"},
+		Short:  `Explain where the credentials in use come from`,
+		Long: `Walk through every place the CLI looks for credentials, in priority order, and show which one is used.
+
+The profile is selected, in order, with the --profile flag, the SCW_PROFILE environment variable or the active_profile of the config file.
+
+Credentials are looked up in the following order:
+
+	- environment variables (SCW_ACCESS_KEY, SCW_SECRET_KEY)
+	- the selected profile of the config file
+	- the default profile of the config file
+
+A secret key stored with scw init keyring=true is a reference to the system keyring, the secret is read from the keyring.
+A secret key stored with scw init encrypt=true is decrypted with a passphrase.
+
+The secret key of a profile created with scw init was itself resolved, in order, from the secret-key argument, the credential-command output, the secret-key-file content or a prompt.
+
+Secrets are always masked.`,
+		Namespace:            "config",
+		Resource:             "explain-auth",
+		AllowAnonymousClient: true,
+		ArgsType:             reflect.TypeOf(configExplainAuthArgs{}),
+		SeeAlsos: []*core.SeeAlso{
+			{
+				Short:   "Get info about current settings",
+				Command: "scw info",
+			},
+			{
+				Short:   "Initialize a profile",
+				Command: "scw init",
+			},
+		},
+		Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+			configPath := core.ExtractConfigPath(ctx)
+			config, err := scw.LoadConfigFromPath(configPath)
+			if err != nil && !isConfigFileNotFoundError(err) {
+				return nil, err
+			}
+
+			return explainAuth(ctx, config, core.ExtractProfileName(ctx)), nil
+		},
+	}
+}
+
+type authSource struct {
+	Key    string
+	Source string
+	Value  string
+	Used   bool
+}
+
+// explainAuth lists, for each credential key, every source in priority order.
+// The first source with a value is the one used.
+func explainAuth(ctx context.Context, config *scw.Config, profileName string) []*authSource {
+	type candidate struct {
+		source string
+		value  *string
+	}
+
+	sources := explainProfile(ctx, config)
+	for _, key := range []struct {
+		name      string
+		env       string
+		fromFile  func(p *scw.Profile) *string
+		isPrivate bool
+	}{
+		{name: "access_key", env: scw.ScwAccessKeyEnv, fromFile: func(p *scw.Profile) *string { return p.AccessKey }},
+		{name: "secret_key", env: scw.ScwSecretKeyEnv, fromFile: func(p *scw.Profile) *string { return p.SecretKey }, isPrivate: true},
+	} {
+		candidates := []candidate{
+			{source: fmt.Sprintf("env (%s)", key.env), value: scw.StringPtr(core.ExtractEnv(ctx, key.env))},
+		}
+		if config != nil {
+			if profile, exists := config.Profiles[profileName]; exists && profileName != scw.DefaultProfileName {
+				candidates = append(candidates, candidate{source: fmt.Sprintf("profile (%s)", profileName), value: key.fromFile(profile)})
+			}
+			candidates = append(candidates, candidate{source: "default profile", value: key.fromFile(&config.Profile)})
+		}
+
+		used := false
+		for _, c := range candidates {
+			value := ""
+			if c.value != nil {
+				value = *c.value
+			}
+			source := &authSource{
+				Key:    key.name,
+				Source: c.source,
+				Value:  value,
+				Used:   !used && value != "",
+			}
+			if source.Used {
+				used = true
+			}
+			if key.isPrivate {
+				sources = append(sources, explainSecret(source)...)
+				continue
+			}
+			sources = append(sources, source)
+		}
+	}
+
+	return sources
+}
+
+// explainProfile lists the sources of the selected profile in priority order, the same as core.ExtractProfileName.
+func explainProfile(ctx context.Context, config *scw.Config) []*authSource {
+	candidates := []*authSource{
+		{Source: "flag (--profile)", Value: core.ExtractProfileFlag(ctx)},
+		{Source: fmt.Sprintf("env (%s)", scw.ScwActiveProfileEnv), Value: core.ExtractEnv(ctx, scw.ScwActiveProfileEnv)},
+	}
+	if config != nil && config.ActiveProfile != nil {
+		candidates = append(candidates, &authSource{Source: "config (active_profile)", Value: *config.ActiveProfile})
+	}
+	candidates = append(candidates, &authSource{Source: "default", Value: scw.DefaultProfileName})
+
+	used := false
+	for _, c := range candidates {
+		c.Key = "profile"
+		c.Used = !used && c.Value != ""
+		if c.Used {
+			used = true
+		}
+	}
+
+	return candidates
+}
+
+// explainSecret masks the secret key of source.
+// A reference to the system keyring is shown as is, followed by the keyring entry it points to,
+// and an encrypted secret key is only labelled as such.
+func explainSecret(source *authSource) []*authSource {
+	switch {
+	case keyring.IsReference(source.Value):
+		keyringSource := &authSource{
+			Key:    source.Key,
+			Source: fmt.Sprintf("system keyring (%s)", source.Value),
+		}
+		if secret, err := keyring.Resolve(source.Value); err == nil {
+			keyringSource.Value = redact.Secret(secret)
+			keyringSource.Used = source.Used
+		}
+		source.Value += " (reference)"
+
+		return []*authSource{source, keyringSource}
+	case passphrase.IsEncrypted(source.Value):
+		source.Value = "encrypted with a passphrase"
+	default:
+		source.Value = redact.Secret(source.Value)
+	}
+
+	return []*authSource{source}
+}
+
+func isConfigFileNotFoundError(err error) bool {
+	target := &scw.ConfigFileNotFoundError{}
+	return errors.As(err, &target)
+}
+
 // Helper functions
 func getProfileValue(profile *scw.Profile, fieldName string) (interface{}, error) {
 	field, err := getProfileField(profile, fieldName)
@@ -768,6 +1419,19 @@ func getProfileField(profile *scw.Profile, key string) (reflect.Value, error) {
 	return field, nil
 }
 
+// cliConfigKeys are the keys stored in the CLI config instead of a profile
+var cliConfigKeys = []string{"send-usage", "send-crash-reports"}
+
+// getCliConfigField returns the CLI config field matching key, if key is one of cliConfigKeys
+func getCliConfigField(cfg *cliConfig.Config, key string) (reflect.Value, bool) {
+	for _, cliConfigKey := range cliConfigKeys {
+		if strcase.ToBashArg(key) == cliConfigKey {
+			return reflect.ValueOf(cfg).Elem().FieldByName(strcase.ToPublicGoName(key)), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
 func getProfileKeys() []string {
 	t := reflect.TypeOf(scw.Profile{})
 	keys := []string{}
@@ -797,6 +1461,14 @@ func getProfile(config *scw.Config, profileName string) (*scw.Profile, error) {
 	return profile, nil
 }
 
+// deleteProfileSecret removes the secret key of a deleted profile from the system keyring when it was stored there by scw init.
+func deleteProfileSecret(profile *scw.Profile) error {
+	if profile == nil || profile.SecretKey == nil || !keyring.IsReference(*profile.SecretKey) {
+		return nil
+	}
+	return keyring.Delete(*profile.SecretKey)
+}
+
 func validateProfile(profile *scw.Profile) error {
 	if err := validateAccessKey(profile); err != nil {
 		return err
@@ -842,6 +1514,12 @@ func validateSecretKey(profile *scw.Profile) error {
 			}
 		}
 
+		// scw init keyring=true writes a reference to the system keyring instead of the secret key,
+		// scw init encrypt=true writes the secret key encrypted with a passphrase
+		if keyring.IsReference(*profile.SecretKey) || passphrase.IsEncrypted(*profile.SecretKey) {
+			return nil
+		}
+
 		if !validation.IsSecretKey(*profile.SecretKey) {
 			return core.InvalidSecretKeyError(*profile.SecretKey)
 		}
@@ -1,16 +1,21 @@
 package config_test
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path"
 	"regexp"
 	"testing"
+	"time"
 
+	cliConfig "github.com/scaleway/scaleway-cli/v2/internal/config"
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/config"
+	"github.com/scaleway/scaleway-cli/v2/internal/passphrase"
 
 	"github.com/alecthomas/assert"
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/keyring"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/stretchr/testify/require"
 )
@@ -49,6 +54,43 @@ func Test_ConfigGetCommand(t *testing.T) {
 		TmpHomeDir: true,
 	}))
 
+	t.Run("Send usage without config file", core.Test(&core.TestConfig{
+		Commands: config.GetCommands(),
+		BeforeFunc: func(ctx *core.BeforeFuncCtx) error {
+			ctx.Meta["CliConfigPath"] = path.Join(ctx.OverrideEnv["HOME"], ".config", "scw", cliConfig.DefaultConfigFileName)
+			return nil
+		},
+		Cmd: "scw config set send-usage=false",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				cliCfg, err := cliConfig.LoadConfig(ctx.Meta["CliConfigPath"].(string))
+				require.NoError(t, err)
+				require.NotNil(t, cliCfg.SendUsage)
+				assert.False(t, *cliCfg.SendUsage)
+			},
+		),
+		TmpHomeDir: true,
+	}))
+
+	t.Run("Send usage and profile setting without config file", core.Test(&core.TestConfig{
+		Commands: config.GetCommands(),
+		BeforeFunc: func(ctx *core.BeforeFuncCtx) error {
+			ctx.Meta["CliConfigPath"] = path.Join(ctx.OverrideEnv["HOME"], ".config", "scw", cliConfig.DefaultConfigFileName)
+			return nil
+		},
+		Cmd: "scw config set send-usage=false default-region=nl-ams",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				// Nothing is saved when the profile config is missing
+				_, err := os.Stat(ctx.Meta["CliConfigPath"].(string))
+				assert.True(t, os.IsNotExist(err))
+			},
+		),
+		TmpHomeDir: true,
+	}))
+
 	t.Run("Unknown Profile", core.Test(&core.TestConfig{
 		Commands:   config.GetCommands(),
 		BeforeFunc: beforeFuncCreateFullConfig(),
@@ -104,6 +146,30 @@ func Test_ConfigSetCommand(t *testing.T) {
 		TmpHomeDir: true,
 	}))
 
+	t.Run("Send usage", core.Test(&core.TestConfig{
+		Commands: config.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			beforeFuncCreateFullConfig(),
+			func(ctx *core.BeforeFuncCtx) error {
+				ctx.Meta["CliConfigPath"] = path.Join(ctx.OverrideEnv["HOME"], ".config", "scw", cliConfig.DefaultConfigFileName)
+				return nil
+			},
+		),
+		Cmd: "scw config set send-usage=false send-crash-reports=true",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				cliCfg, err := cliConfig.LoadConfig(ctx.Meta["CliConfigPath"].(string))
+				require.NoError(t, err)
+				require.NotNil(t, cliCfg.SendUsage)
+				assert.False(t, *cliCfg.SendUsage)
+				require.NotNil(t, cliCfg.SendCrashReports)
+				assert.True(t, *cliCfg.SendCrashReports)
+			},
+		),
+		TmpHomeDir: true,
+	}))
+
 	t.Run("Unknown Profile", core.Test(&core.TestConfig{
 		Commands:   config.GetCommands(),
 		BeforeFunc: beforeFuncCreateFullConfig(),
@@ -174,6 +240,34 @@ func Test_ConfigUnsetCommand(t *testing.T) {
 	}))
 }
 
+func Test_ConfigCreateProfileCommand(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands:   config.GetCommands(),
+		BeforeFunc: beforeFuncCreateFullConfig(),
+		Cmd:        "scw config profile create p3 activate=true",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+			checkConfig(func(t *testing.T, config *scw.Config) {
+				assert.NotNil(t, config.Profiles["p3"])
+				assert.Equal(t, "p3", *config.ActiveProfile)
+			}),
+		),
+		TmpHomeDir: true,
+	}))
+
+	t.Run("Existing Profile", core.Test(&core.TestConfig{
+		Commands:   config.GetCommands(),
+		BeforeFunc: beforeFuncCreateFullConfig(),
+		Cmd:        "scw config profile create p2",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+		TmpHomeDir: true,
+	}))
+}
+
 func Test_ConfigDeleteProfileCommand(t *testing.T) {
 	t.Run("Simple", core.Test(&core.TestConfig{
 		Commands:   config.GetCommands(),
@@ -189,6 +283,38 @@ func Test_ConfigDeleteProfileCommand(t *testing.T) {
 		TmpHomeDir: true,
 	}))
 
+	t.Run("Secret key in the system keyring", core.Test(&core.TestConfig{
+		Commands: config.GetCommands(),
+		BeforeFunc: func(ctx *core.BeforeFuncCtx) error {
+			keyring.MockInit()
+			reference, err := keyring.Store("p2", "11111111-1111-1111-1111-111111111111")
+			if err != nil {
+				return err
+			}
+			return beforeFuncCreateConfigFile(&scw.Config{
+				Profile: scw.Profile{
+					AccessKey: scw.StringPtr("SCWXXXXXXXXXXXXXXXXX"),
+					SecretKey: scw.StringPtr("11111111-1111-1111-1111-111111111111"),
+				},
+				Profiles: map[string]*scw.Profile{
+					"p2": {
+						AccessKey: scw.StringPtr("SCWXXXXXXXXXXXXXXXXX"),
+						SecretKey: scw.StringPtr(reference),
+					},
+				},
+			})(ctx)
+		},
+		Cmd: "scw config profile delete p2",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, _ *core.CheckFuncCtx) {
+				_, err := keyring.Resolve("keyring:p2")
+				assert.True(t, errors.Is(err, keyring.ErrNotFound))
+			},
+		),
+		TmpHomeDir: true,
+	}))
+
 	t.Run("Unknown Profile", core.Test(&core.TestConfig{
 		Commands:   config.GetCommands(),
 		BeforeFunc: beforeFuncCreateFullConfig(),
@@ -201,6 +327,33 @@ func Test_ConfigDeleteProfileCommand(t *testing.T) {
 	}))
 }
 
+func Test_ConfigListProfileCommand(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands:   config.GetCommands(),
+		BeforeFunc: beforeFuncCreateFullConfig(),
+		Cmd:        "scw config profile list",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+		TmpHomeDir: true,
+	}))
+
+	t.Run("Resolve here", core.Test(&core.TestConfig{
+		Commands:   config.GetCommands(),
+		BeforeFunc: beforeFuncCreateFullConfig(),
+		Cmd:        "scw config profile list resolve-here=true",
+		OverrideEnv: map[string]string{
+			scw.ScwActiveProfileEnv: "p2",
+		},
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+		TmpHomeDir: true,
+	}))
+}
+
 func Test_ConfigDumpCommand(t *testing.T) {
 	t.Run("Simple", core.Test(&core.TestConfig{
 		Commands:   config.GetCommands(),
@@ -349,6 +502,26 @@ func Test_ConfigValidateCommand(t *testing.T) {
 		),
 		TmpHomeDir: true,
 	}))
+	t.Run("Live", core.Test(&core.TestConfig{
+		Commands:   config.GetCommands(),
+		BeforeFunc: beforeFuncCreateFullConfig(),
+		Cmd:        "scw config validate live=true",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+		TmpHomeDir: true,
+	}))
+	t.Run("Live wrong organization", core.Test(&core.TestConfig{
+		Commands:   config.GetCommands(),
+		BeforeFunc: beforeFuncCreateFullConfig(),
+		Cmd:        "scw config validate live=true",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			core.TestCheckGolden(),
+		),
+		TmpHomeDir: true,
+	}))
 	t.Run("Invalid default access key", core.Test(&core.TestConfig{
 		Commands:   config.GetCommands(),
 		BeforeFunc: beforeFuncCreateInvalidConfig(),
@@ -372,6 +545,183 @@ func Test_ConfigValidateCommand(t *testing.T) {
 		),
 		TmpHomeDir: true,
 	}))
+	t.Run("Keyring secret key", core.Test(&core.TestConfig{
+		Commands: config.GetCommands(),
+		BeforeFunc: beforeFuncCreateConfigFile(&scw.Config{
+			Profile: scw.Profile{
+				AccessKey: scw.StringPtr("SCWXXXXXXXXXXXXXXXXX"),
+				SecretKey: scw.StringPtr("keyring:default"),
+			},
+		}),
+		Cmd: "scw config validate",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+		TmpHomeDir: true,
+	}))
+	t.Run("Encrypted secret key", core.Test(&core.TestConfig{
+		Commands: config.GetCommands(),
+		BeforeFunc: func(ctx *core.BeforeFuncCtx) error {
+			secretKey, err := passphrase.Encrypt("11111111-1111-1111-1111-111111111111", "passphrase")
+			if err != nil {
+				return err
+			}
+			return beforeFuncCreateConfigFile(&scw.Config{
+				Profile: scw.Profile{
+					AccessKey: scw.StringPtr("SCWXXXXXXXXXXXXXXXXX"),
+					SecretKey: scw.StringPtr(secretKey),
+				},
+			})(ctx)
+		},
+		Cmd: "scw config validate",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+		TmpHomeDir: true,
+	}))
+}
+
+func Test_ConfigExplainAuthCommand(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands:   config.GetCommands(),
+		BeforeFunc: beforeFuncCreateFullConfig(),
+		Cmd:        "scw -p p1 config explain-auth",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+		),
+		TmpHomeDir: true,
+	}))
+
+	t.Run("Env wins over profile", core.Test(&core.TestConfig{
+		Commands:   config.GetCommands(),
+		BeforeFunc: beforeFuncCreateFullConfig(),
+		Cmd:        "scw config explain-auth",
+		OverrideEnv: map[string]string{
+			scw.ScwSecretKeyEnv: "22222222-2222-2222-2222-222222222222",
+		},
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.NotContains(t, string(ctx.Stdout), "22222222-2222-2222-2222-222222222222")
+			},
+		),
+		TmpHomeDir: true,
+	}))
+
+	t.Run("Keyring reference", core.Test(&core.TestConfig{
+		Commands: config.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			beforeFuncCreateConfigFile(&scw.Config{
+				Profile: scw.Profile{
+					AccessKey: scw.StringPtr("SCWXXXXXXXXXXXXXXXXX"),
+					SecretKey: scw.StringPtr("keyring:default"),
+				},
+			}),
+			func(*core.BeforeFuncCtx) error {
+				keyring.MockInit()
+				_, err := keyring.Store("default", "33333333-3333-3333-3333-333333333333")
+				return err
+			},
+		),
+		Cmd: "scw config explain-auth",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			core.TestCheckGolden(),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.NotContains(t, string(ctx.Stdout), "33333333-3333-3333-3333-333333333333")
+			},
+		),
+		TmpHomeDir: true,
+	}))
+}
+
+func Test_ConfigPruneCommand(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands: config.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			beforeFuncCreateConfigFile(&scw.Config{
+				Profile: scw.Profile{
+					AccessKey: scw.StringPtr("SCWXXXXXXXXXXXXXXXXX"),
+					SecretKey: scw.StringPtr("11111111-1111-1111-1111-111111111111"),
+				},
+				ActiveProfile: scw.StringPtr("active"),
+				Profiles: map[string]*scw.Profile{
+					"stale":  {DefaultZone: scw.StringPtr("fr-par-1"), SecretKey: scw.StringPtr("keyring:stale")},
+					"active": {DefaultZone: scw.StringPtr("fr-par-2")},
+					"recent": {DefaultZone: scw.StringPtr("nl-ams-1")},
+				},
+			}),
+			func(ctx *core.BeforeFuncCtx) error {
+				keyring.MockInit()
+				_, err := keyring.Store("stale", "11111111-1111-1111-1111-111111111111")
+				if err != nil {
+					return err
+				}
+				cliCfg, err := cliConfig.LoadConfig(path.Join(ctx.OverrideEnv["HOME"], ".config", "scw", cliConfig.DefaultConfigFileName))
+				if err != nil {
+					return err
+				}
+				cliCfg.ProfilesUpdatedAt = map[string]time.Time{
+					"stale":  time.Now().Add(-100 * 24 * time.Hour),
+					"active": time.Now().Add(-100 * 24 * time.Hour),
+					"recent": time.Now(),
+				}
+				return cliCfg.Save()
+			},
+		),
+		Cmd: "scw config prune days=30",
+		PromptResponseMocks: []string{
+			// Profile stale has not been updated for 30 days, do you want to delete it?
+			"yes",
+		},
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stdout), "deleted")
+				assert.Contains(t, string(ctx.Stdout), "kept, active profile")
+				assert.NotContains(t, string(ctx.Stdout), "recent")
+				_, err := keyring.Resolve("keyring:stale")
+				assert.True(t, errors.Is(err, keyring.ErrNotFound))
+			},
+			checkConfig(func(t *testing.T, config *scw.Config) {
+				assert.Nil(t, config.Profiles["stale"])
+				assert.NotNil(t, config.Profiles["active"])
+				assert.NotNil(t, config.Profiles["recent"])
+			}),
+		),
+		TmpHomeDir: true,
+	}))
+
+	t.Run("Profile without update date", core.Test(&core.TestConfig{
+		Commands: config.GetCommands(),
+		BeforeFunc: beforeFuncCreateConfigFile(&scw.Config{
+			Profile: scw.Profile{
+				AccessKey: scw.StringPtr("SCWXXXXXXXXXXXXXXXXX"),
+				SecretKey: scw.StringPtr("11111111-1111-1111-1111-111111111111"),
+			},
+			Profiles: map[string]*scw.Profile{
+				"unknown": {DefaultZone: scw.StringPtr("fr-par-1")},
+			},
+		}),
+		Cmd: "scw config prune days=30",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.NotContains(t, string(ctx.Stdout), "unknown")
+				cliCfg, err := cliConfig.LoadConfig(path.Join(ctx.OverrideEnv["HOME"], ".config", "scw", cliConfig.DefaultConfigFileName))
+				require.NoError(t, err)
+				assert.WithinDuration(t, time.Now(), cliCfg.ProfilesUpdatedAt["unknown"], time.Minute)
+			},
+			checkConfig(func(t *testing.T, config *scw.Config) {
+				assert.NotNil(t, config.Profiles["unknown"])
+			}),
+		),
+		TmpHomeDir: true,
+	}))
 }
 
 func checkConfig(f func(t *testing.T, config *scw.Config)) core.TestCheck {
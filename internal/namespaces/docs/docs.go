@@ -0,0 +1,106 @@
+package docs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/docgen"
+)
+
+func GetCommands() *core.Commands {
+	return core.NewCommands(
+		docsRoot(),
+		docsManCommand(),
+		docsMarkdownCommand(),
+	)
+}
+
+func docsRoot() *core.Command {
+	return &core.Command{
+		Short:     "Documentation generation",
+		Long:      "Generate the documentation of the commands of the CLI, e.g. to package it.",
+		Namespace: "docs",
+		Hidden:    true,
+	}
+}
+
+type docsManArgs struct {
+	OutputDir string
+}
+
+func docsManCommand() *core.Command {
+	return &core.Command{
+		Short:                "Generate man pages",
+		Long:                 "Generate a man page in section 1 for each command, e.g. scw-instance-server-list.1.",
+		Namespace:            "docs",
+		Resource:             "man",
+		Hidden:               true,
+		AllowAnonymousClient: true,
+		ArgsType:             reflect.TypeOf(docsManArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:     "output-dir",
+				Short:    "Directory where the man pages are written",
+				Required: true,
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (interface{}, error) {
+			args := argsI.(*docsManArgs)
+
+			err := os.MkdirAll(args.OutputDir, 0755)
+			if err != nil {
+				return nil, err
+			}
+			err = docgen.GenerateManPages(core.ExtractCommands(ctx), args.OutputDir)
+			if err != nil {
+				return nil, err
+			}
+
+			return &core.SuccessResult{
+				Message: fmt.Sprintf("Man pages generated in %s", args.OutputDir),
+			}, nil
+		},
+	}
+}
+
+type docsMarkdownArgs struct {
+	OutputDir string
+}
+
+func docsMarkdownCommand() *core.Command {
+	return &core.Command{
+		Short:                "Generate markdown documentation",
+		Long:                 "Generate a markdown file for each namespace with the arguments, enum values, defaults and examples of its commands, as done by scw-doc-gen.",
+		Namespace:            "docs",
+		Resource:             "markdown",
+		Hidden:               true,
+		AllowAnonymousClient: true,
+		ArgsType:             reflect.TypeOf(docsMarkdownArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:    "output-dir",
+				Short:   "Directory where the markdown files are written",
+				Default: core.DefaultValueSetter("./docs/commands"),
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (interface{}, error) {
+			args := argsI.(*docsMarkdownArgs)
+
+			err := os.MkdirAll(args.OutputDir, 0755)
+			if err != nil {
+				return nil, err
+			}
+			err = docgen.GenerateDocs(core.ExtractCommands(ctx), args.OutputDir)
+			if err != nil {
+				return nil, err
+			}
+
+			return &core.SuccessResult{
+				Message: fmt.Sprintf("Markdown documentation generated in %s", args.OutputDir),
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package docs_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/docs"
+	"github.com/stretchr/testify/require"
+)
+
+func testCommands() *core.Commands {
+	cmds := docs.GetCommands()
+	cmds.Merge(core.NewCommands(
+		&core.Command{
+			Namespace: "instance",
+			Short:     "Instance API",
+		},
+		&core.Command{
+			Namespace: "instance",
+			Resource:  "server",
+			Short:     "Server management commands",
+		},
+		&core.Command{
+			Namespace: "instance",
+			Resource:  "server",
+			Verb:      "list",
+			Short:     "List servers",
+			Long:      "List all the servers of a project.\n\n.Dots and back\\slashes are escaped.",
+			ArgSpecs: core.ArgSpecs{
+				{
+					Name:       "state",
+					Short:      "State of the servers",
+					EnumValues: []string{"running", "stopped"},
+				},
+				{
+					Name:     "project-id",
+					Required: true,
+				},
+			},
+			Examples: []*core.Example{
+				{
+					Short: "List running servers",
+					Raw:   "scw instance server list state=running",
+				},
+			},
+		},
+	))
+	return cmds
+}
+
+func Test_DocsMan(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands: testCommands(),
+		Args:     []string{"scw", "docs", "man", "output-dir=" + dir},
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, _ *core.CheckFuncCtx) {
+				entries, err := os.ReadDir(dir)
+				require.NoError(t, err)
+				pages := []string(nil)
+				for _, entry := range entries {
+					pages = append(pages, entry.Name())
+				}
+				assert.Equal(t, []string{"scw-instance-server-list.1", "scw-instance-server.1", "scw-instance.1"}, pages)
+
+				content, err := os.ReadFile(filepath.Join(dir, "scw-instance-server-list.1"))
+				require.NoError(t, err)
+				assert.Equal(t, `.TH "SCW-INSTANCE-SERVER-LIST" "1" "" "scw" "Scaleway CLI"
+.SH NAME
+scw-instance-server-list \- List servers
+.SH SYNOPSIS
+.B scw instance server list [arg=value ...]
+.SH DESCRIPTION
+List all the servers of a project.
+.PP
+\&.Dots and back\eslashes are escaped.
+.SH ARGUMENTS
+.TP
+.B state (one of: running, stopped)
+State of the servers
+.TP
+.B project\-id (required)
+.SH EXAMPLES
+.PP
+List running servers
+.RS 4
+.nf
+scw instance server list state=running
+.fi
+.RE
+.SH SEE ALSO
+\fBscw\-instance\-server\fR(1)
+`, string(content))
+			},
+		),
+	}))
+}
+
+func Test_DocsMarkdown(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands: testCommands(),
+		Args:     []string{"scw", "docs", "markdown", "output-dir=" + dir},
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, _ *core.CheckFuncCtx) {
+				content, err := os.ReadFile(filepath.Join(dir, "instance.md"))
+				require.NoError(t, err)
+				assert.Contains(t, string(content), "| state | One of: `running`, `stopped` | State of the servers |")
+				assert.Contains(t, string(content), "scw instance server list state=running")
+
+				_, err = os.Stat(filepath.Join(dir, "docs.md"))
+				assert.True(t, os.IsNotExist(err), "hidden commands must not be documented")
+			},
+		),
+	}))
+}
@@ -26,22 +26,54 @@ func feedbackRoot() *core.Command {
 }
 
 func feedbackBugCommand() *core.Command {
+	type bugArgs struct {
+		Print bool
+	}
+
 	return &core.Command{
-		Groups:               []string{"utility"},
-		Short:                `Send a bug-report`,
-		Long:                 `Send a bug-report to the Scaleway CLI team.`,
-		Namespace:            "feedback",
-		Resource:             `bug`,
-		ArgsType:             reflect.TypeOf(struct{}{}),
-		ArgSpecs:             core.ArgSpecs{},
+		Groups: []string{"utility"},
+		Short:  `Send a bug-report`,
+		Long: `Send a bug-report to the Scaleway CLI team.
+The report is prefilled with the version of the CLI, the profile, default region and zone used, and the debug trace of the last command run with --debug.
+Credentials are never part of the report and secrets are masked in the debug trace.`,
+		Namespace: "feedback",
+		Resource:  `bug`,
+		ArgsType:  reflect.TypeOf(bugArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:    "print",
+				Short:   "Print the report instead of opening a GitHub issue",
+				Default: core.DefaultValueSetter("false"),
+			},
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Report a bug with the debug trace of a failing command",
+				Raw: `scw instance server list --debug
+scw feedback bug`,
+			},
+		},
 		AllowAnonymousClient: true,
 
-		Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			args := argsI.(*bugArgs)
+
+			debugTrace, err := core.ReadLastDebugTrace(ctx)
+			if err != nil {
+				return nil, err
+			}
 			issue := issue{
 				IssueTemplate: bug,
 				BuildInfo:     core.ExtractBuildInfo(ctx),
+				Config:        newConfigSummary(ctx),
+				DebugTrace:    debugTrace,
 			}
-			err := issue.openInBrowser(ctx)
+
+			if args.Print {
+				return issue.renderTemplate(bugBodyTemplate)
+			}
+
+			err = issue.openInBrowser(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -1,7 +1,9 @@
 package feedback_test
 
 import (
+	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"testing"
 
@@ -9,12 +11,15 @@ import (
 
 	"github.com/alecthomas/assert"
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 func Test_FeedbackBug(t *testing.T) {
 	t.Run("simple", core.Test(&core.TestConfig{
 		Commands: feedback.GetCommands(),
 		Cmd:      "scw feedback bug",
+		// The cache directory keeps the debug trace of the last command
+		TmpHomeDir: true,
 		OverrideExec: func(_ *core.ExecFuncCtx, cmd *exec.Cmd) (exitCode int, err error) {
 			var observed string
 			switch runtime.GOOS {
@@ -25,7 +30,7 @@ func Test_FeedbackBug(t *testing.T) {
 				observed = cmd.Args[1]
 			}
 			assert.Equal(t,
-				"https://github.com/scaleway/scaleway-cli/issues/new?body=%0A%23%23+Description%3A%0A%0A%23%23+How+to+reproduce%3A%0A%0A%23%23%23+Command+attempted%0A%0A%23%23%23+Expected+Behavior%0A%0A%23%23%23+Actual+Behavior%0A%0A%23%23+More+info%0A%0A%23%23+Version%0A%0AVersion++++0.0.0%26%2343%3Btest%0ABuildDate++unknown%0AGoVersion++runtime.Version%28%29%0AGitBranch++unknown%0AGitCommit++unknown%0AGoArch+++++runtime.GOARCH%0AGoOS+++++++runtime.GOOS%0A&issueTemplate=bug_report.md&labels=bug",
+				"https://github.com/scaleway/scaleway-cli/issues/new?body=%0A%23%23+Description%3A%0A%0A%23%23+How+to+reproduce%3A%0A%0A%23%23%23+Command+attempted%0A%0A%23%23%23+Expected+Behavior%0A%0A%23%23%23+Actual+Behavior%0A%0A%23%23+More+info%0A%0A%23%23+Version%0A%0AVersion++++0.0.0%2Btest%0ABuildDate++unknown%0AGoVersion++runtime.Version%28%29%0AGitBranch++unknown%0AGitCommit++unknown%0AGoArch+++++runtime.GOARCH%0AGoOS+++++++runtime.GOOS%0A%0A%23%23+Config%0A%0AProfile++++++++default%0ADefaultRegion++fr-par%0ADefaultZone++++fr-par-1%0A&issueTemplate=bug_report.md&labels=bug",
 				observed)
 
 			return 0, nil
@@ -35,6 +40,23 @@ func Test_FeedbackBug(t *testing.T) {
 			core.TestCheckExitCode(0),
 		),
 	}))
+
+	t.Run("print with debug trace", core.Test(&core.TestConfig{
+		Commands:   feedback.GetCommands(),
+		Cmd:        "scw feedback bug print=true",
+		TmpHomeDir: true,
+		BeforeFunc: func(ctx *core.BeforeFuncCtx) error {
+			return os.WriteFile(
+				filepath.Join(ctx.OverrideEnv[scw.ScwCacheDirEnv], "last-debug.log"),
+				[]byte("running: [scw instance server list --debug]\nGET https://api.scaleway.com/instance/v1/zones/fr-par-1/servers\n"),
+				0o600,
+			)
+		},
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+	}))
 }
 
 func Test_FeedbackFeature(t *testing.T) {
@@ -51,7 +73,7 @@ func Test_FeedbackFeature(t *testing.T) {
 				observed = cmd.Args[1]
 			}
 			assert.Equal(t,
-				"https://github.com/scaleway/scaleway-cli/issues/new?body=%0A%23%23+Description%0A%0A%23%23+How+this+functionality+would+be+exposed%0A%0A%23%23+References%0A%0A%23%23+Version%0A%0AVersion++++0.0.0%26%2343%3Btest%0ABuildDate++unknown%0AGoVersion++runtime.Version%28%29%0AGitBranch++unknown%0AGitCommit++unknown%0AGoArch+++++runtime.GOARCH%0AGoOS+++++++runtime.GOOS%0A&issueTemplate=feature_request.md&labels=enhancement",
+				"https://github.com/scaleway/scaleway-cli/issues/new?body=%0A%23%23+Description%0A%0A%23%23+How+this+functionality+would+be+exposed%0A%0A%23%23+References%0A%0A%23%23+Version%0A%0AVersion++++0.0.0%2Btest%0ABuildDate++unknown%0AGoVersion++runtime.Version%28%29%0AGitBranch++unknown%0AGitCommit++unknown%0AGoArch+++++runtime.GOARCH%0AGoOS+++++++runtime.GOOS%0A&issueTemplate=feature_request.md&labels=enhancement",
 				observed)
 
 			return 0, nil
@@ -61,4 +83,5 @@ func Test_FeedbackFeature(t *testing.T) {
 			core.TestCheckExitCode(0),
 		),
 	}))
+
 }
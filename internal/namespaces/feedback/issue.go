@@ -4,11 +4,12 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"html/template"
 	"log"
 	"net/url"
 	"os/exec"
 	"runtime"
+	"strings"
+	"text/template"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
 	"github.com/scaleway/scaleway-cli/v2/internal/human"
@@ -26,9 +27,36 @@ const (
 	Windows = "windows"
 )
 
+// debugTraceMaxLength keeps the end of the debug trace of a bug report so that the URL of the issue stays under the length accepted by GitHub
+const debugTraceMaxLength = 4000
+
 type issue struct {
 	IssueTemplate issueTemplate
 	BuildInfo     *core.BuildInfo
+	// Config and DebugTrace are only part of bug reports
+	Config     *configSummary
+	DebugTrace string
+}
+
+// configSummary describes the config used by the CLI, it never contains credentials
+type configSummary struct {
+	Profile       string
+	DefaultRegion string
+	DefaultZone   string
+}
+
+func newConfigSummary(ctx context.Context) *configSummary {
+	summary := &configSummary{
+		Profile: core.ExtractProfileName(ctx),
+	}
+	client := core.ExtractClient(ctx)
+	if region, exists := client.GetDefaultRegion(); exists {
+		summary.DefaultRegion = region.String()
+	}
+	if zone, exists := client.GetDefaultZone(); exists {
+		summary.DefaultZone = zone.String()
+	}
+	return summary
 }
 
 const bugBodyTemplate = `
@@ -47,6 +75,18 @@ const bugBodyTemplate = `
 ## Version
 
 {{ .BuildInfoStr }}
+
+## Config
+
+{{ .ConfigStr }}
+{{- if .DebugTrace }}
+
+## Debug trace of the last command run with --debug
+
+` + "```" + `
+{{ .DebugTrace }}
+` + "```" + `
+{{- end }}
 `
 
 const featureBodyTemplate = `
@@ -118,11 +158,26 @@ func (i issue) renderTemplate(bodyTemplate string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	configStr := ""
+	if i.Config != nil {
+		configStr, err = human.Marshal(i.Config, nil)
+		if err != nil {
+			return "", err
+		}
+	}
+	debugTrace := i.DebugTrace
+	if len(debugTrace) > debugTraceMaxLength {
+		debugTrace = "[...]\n" + debugTrace[len(debugTrace)-debugTraceMaxLength:]
+	}
 	var buf bytes.Buffer
 	err = tmpl.Execute(&buf, struct {
 		BuildInfoStr string
+		ConfigStr    string
+		DebugTrace   string
 	}{
 		BuildInfoStr: buildInfoStr,
+		ConfigStr:    configStr,
+		DebugTrace:   strings.TrimSpace(debugTrace),
 	})
 	if err != nil {
 		return "", err
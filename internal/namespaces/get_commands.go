@@ -4,6 +4,7 @@ import (
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
 	accountv3 "github.com/scaleway/scaleway-cli/v2/internal/namespaces/account/v3"
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/alias"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/api"
 	applesilicon "github.com/scaleway/scaleway-cli/v2/internal/namespaces/applesilicon/v1alpha1"
 	autocompleteNamespace "github.com/scaleway/scaleway-cli/v2/internal/namespaces/autocomplete"
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/baremetal/v1"
@@ -12,6 +13,7 @@ import (
 	cockpit "github.com/scaleway/scaleway-cli/v2/internal/namespaces/cockpit/v1beta1"
 	configNamespace "github.com/scaleway/scaleway-cli/v2/internal/namespaces/config"
 	container "github.com/scaleway/scaleway-cli/v2/internal/namespaces/container/v1beta1"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/docs"
 	documentdb "github.com/scaleway/scaleway-cli/v2/internal/namespaces/documentdb/v1beta1"
 	domain "github.com/scaleway/scaleway-cli/v2/internal/namespaces/domain/v2beta1"
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/feedback"
@@ -36,10 +38,12 @@ import (
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/rdb/v1"
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/redis/v1"
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/registry/v1"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/search"
 	secret "github.com/scaleway/scaleway-cli/v2/internal/namespaces/secret/v1beta1"
 	serverless_sqldb "github.com/scaleway/scaleway-cli/v2/internal/namespaces/serverless_sqldb/v1alpha1"
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/shell"
 	tem "github.com/scaleway/scaleway-cli/v2/internal/namespaces/tem/v1alpha1"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/update"
 	versionNamespace "github.com/scaleway/scaleway-cli/v2/internal/namespaces/version"
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/vpc/v2"
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/vpcgw/v1"
@@ -68,9 +72,11 @@ func GetCommands() *core.Commands {
 		autocompleteNamespace.GetCommands(),
 		object.GetCommands(),
 		versionNamespace.GetCommands(),
+		update.GetCommands(),
 		registry.GetCommands(),
 		feedback.GetCommands(),
 		info.GetCommands(),
+		api.GetCommands(),
 		rdb.GetCommands(),
 		lb.GetCommands(),
 		iot.GetCommands(),
@@ -86,6 +92,8 @@ func GetCommands() *core.Commands {
 		secret.GetCommands(),
 		keymanager.GetCommands(),
 		shell.GetCommands(),
+		search.GetCommands(),
+		docs.GetCommands(),
 		tem.GetCommands(),
 		alias.GetCommands(),
 		webhosting.GetCommands(),
@@ -20,6 +20,9 @@ You can select the columns that you want to print with commands that return a li
 	NAME                                            PUBLIC IP
 	scw-cool-franklin                               51.15.251.251
 
+When the output does not fit in your terminal, it is shown through the pager set in the PAGER env variable, 'less -R' by default.
+Use --no-pager to print it directly.
+
 Wide output (Human without column shrinking)
 
 	scw instance server list -o wide
@@ -68,6 +71,51 @@ Standard YAML output
 	send_telemetry: true
 
 
+Query output
+
+You can filter and project the result of any command with a JMESPath expression, before it is printed with the selected output format.
+Visit https://jmespath.org to learn more about JMESPath expressions.
+
+	scw instance server list --query "[?state=='running'].name"
+
+	scw-cool-franklin
+
+	scw instance server list -o json --query "[].{id: id, ip: public_ip.address}"
+
+	[{"id":"088b01da-9ba7-40d2-bc55-eb3170f42185","ip":"51.15.251.251"}]
+
+CSV and TSV output
+
+Commands that return a list can print it as comma or tab-separated values, with a header row.
+Values are quoted when needed. You can select the columns as with the human output.
+
+	scw instance server list -o csv=ID,Name
+
+	ID,NAME
+	11111111-1111-1111-1111-111111111111,foo
+	22222222-2222-2222-2222-222222222222,bar
+
+	scw instance server list -o tsv=ID,Name
+
+IDs output
+
+You can print only the identifiers of the resources returned by a command, one per line, to compose commands.
+
+	scw instance server list -o ids | xargs -n1 scw instance server delete
+
+	088b01da-9ba7-40d2-bc55-eb3170f42185
+
+Progress events
+
+With json output, commands waiting for a resource (-w) report each step of the wait on stderr as a JSON object per line, so CI systems can track long operations.
+
+	scw instance server create image=ubuntu_jammy -w -o json 2>progress.log
+
+	{"time":"2024-06-19T13:22:17Z","event":"wait_started","resource":"server"}
+	{"time":"2024-06-19T13:22:18Z","event":"status_changed","resource":"server","status":"starting"}
+	{"time":"2024-06-19T13:22:41Z","event":"status_changed","resource":"server","status":"running"}
+	{"time":"2024-06-19T13:22:41Z","event":"wait_succeeded","resource":"server"}
+
 Template output
 
 You can use Go template to manipulate the output of a command and create a custom rendering of your resources. 
@@ -77,5 +125,20 @@ Visit https://golang.org/pkg/text/template/ to learn more about Go template form
 
 	foo||11111111-1111-1111-1111-111111111111
 	bar||22222222-2222-2222-2222-222222222222
+
+The following functions are available in templates: upper, lower, join and date.
+
+	scw instance server list -o template="{{ upper .Name }} {{ join .Tags \",\" }} {{ date \"2006-01-02\" .CreationDate }}"
+
+	FOO web,prod 2023-04-24
+	BAR  2023-05-30
+
+Default output format
+
+The output format used when no -o flag is given can be set in the CLI config file (cli.yaml), for all profiles or per profile.
+
+	output: yaml
+	profiles_output:
+	    ci: json
 `
 )
@@ -4,7 +4,9 @@ import (
 	"context"
 	"os"
 	"path"
+	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/fatih/color"
@@ -78,24 +80,15 @@ func setOrganizationDefaultValue(c *core.Command) *core.Command {
 }
 
 func InitWithSSHKeyRun(ctx context.Context, _ interface{}) (i interface{}, e error) {
-	// Get default local SSH key
-	var shortenedFilename string
-	var err error
-	var localSSHKeyContent []byte
-	for _, keyName := range [3]string{"id_ecdsa.pub", "id_ed25519.pub", "id_rsa.pub"} {
-		// element is the element from someSlice for where we are
-		relativePath := path.Join(".ssh", keyName)
-		filename := path.Join(core.ExtractUserHomeDir(ctx), relativePath)
-		shortenedFilename = "~/" + relativePath
-		localSSHKeyContent, err = os.ReadFile(filename)
-		// If we managed to load an ssh key, let's stop there
-		if err == nil {
-			break
-		}
-	}
 	addKeyInstructions := `scw iam ssh-key create name=my-key key="$(cat path/to/my/key.pub)"`
-	if err != nil && os.IsNotExist(err) {
-		return nil, sshKeyNotFound(shortenedFilename, addKeyInstructions)
+
+	// Get local SSH keys
+	localSSHKeys, err := listLocalSSHKeys(core.ExtractUserHomeDir(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(localSSHKeys) == 0 {
+		return nil, sshKeyNotFound("~/.ssh/*.pub", addKeyInstructions)
 	}
 
 	// Get all SSH keys from Scaleway
@@ -106,39 +99,132 @@ func InitWithSSHKeyRun(ctx context.Context, _ interface{}) (i interface{}, e err
 		return nil, err
 	}
 
-	// Early exit if the SSH key is present locally and on Scaleway
-	for _, SSHKey := range listSSHKeysResponse.SSHKeys {
-		if strings.TrimSpace(SSHKey.PublicKey) == strings.TrimSpace(string(localSSHKeyContent)) {
-			_, _ = interactive.Println("Looks like your local SSH key " + shortenedFilename + " is already present in your Scaleway account.")
-			return nil, nil
+	// Only keep the local SSH keys that are not present on Scaleway yet
+	unregisteredSSHKeys := []*localSSHKey(nil)
+	for _, localKey := range localSSHKeys {
+		registered := false
+		for _, SSHKey := range listSSHKeysResponse.SSHKeys {
+			if strings.TrimSpace(SSHKey.PublicKey) == strings.TrimSpace(localKey.Content) {
+				registered = true
+				break
+			}
 		}
+		if registered {
+			_, _ = interactive.Println("Looks like your local SSH key " + localKey.ShortenedFilename + " is already present in your Scaleway account.")
+			continue
+		}
+		unregisteredSSHKeys = append(unregisteredSSHKeys, localKey)
+	}
+
+	// Early exit if all the local SSH keys are present on Scaleway
+	if len(unregisteredSSHKeys) == 0 {
+		return nil, nil
 	}
 
 	// Ask user
 	_, _ = interactive.Println("An SSH key is required if you want to connect to a server. More info at https://www.scaleway.com/en/docs/identity-and-access-management/iam/how-to/create-api-keys/")
-	addSSHKey, err := interactive.PromptBoolWithConfig(&interactive.PromptBoolConfig{
-		Ctx:          ctx,
-		Prompt:       "We found an SSH key in " + shortenedFilename + ". Do you want to add it to your Scaleway project?",
-		DefaultValue: true,
-	})
+	selectedSSHKey, err := promptLocalSSHKey(ctx, unregisteredSSHKeys)
 	if err != nil {
 		return nil, err
 	}
 
 	// Early exit if user doesn't want to add the key
-	if !addSSHKey {
+	if selectedSSHKey == nil {
 		return nil, installationCanceled(addKeyInstructions)
 	}
 
 	// Add key
 	_, err = api.CreateSSHKey(&iam.CreateSSHKeyRequest{
-		PublicKey: string(localSSHKeyContent),
+		PublicKey: selectedSSHKey.Content,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &core.SuccessResult{
-		Message: "Key " + shortenedFilename + " successfully added",
+		Message: "Key " + selectedSSHKey.ShortenedFilename + " successfully added",
 	}, nil
 }
+
+// localSSHKey is a public SSH key found in the .ssh directory of the user
+type localSSHKey struct {
+	ShortenedFilename string
+	Content           string
+}
+
+// preferredSSHKeyNames are listed first, in this order, when several SSH keys are found
+var preferredSSHKeyNames = []string{"id_ecdsa.pub", "id_ed25519.pub", "id_rsa.pub"}
+
+// listLocalSSHKeys returns the public SSH keys found in ~/.ssh, the preferred ones first
+func listLocalSSHKeys(homeDir string) ([]*localSSHKey, error) {
+	filenames, err := filepath.Glob(filepath.Join(homeDir, ".ssh", "*.pub"))
+	if err != nil {
+		return nil, err
+	}
+
+	rank := func(filename string) int {
+		for i, name := range preferredSSHKeyNames {
+			if filepath.Base(filename) == name {
+				return i
+			}
+		}
+		return len(preferredSSHKeyNames)
+	}
+	sort.SliceStable(filenames, func(i, j int) bool {
+		return rank(filenames[i]) < rank(filenames[j])
+	})
+
+	keys := []*localSSHKey(nil)
+	for _, filename := range filenames {
+		content, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, &localSSHKey{
+			ShortenedFilename: "~/" + path.Join(".ssh", filepath.Base(filename)),
+			Content:           string(content),
+		})
+	}
+
+	return keys, nil
+}
+
+// promptLocalSSHKey asks which SSH key should be added, it returns nil if none should be added.
+// The preferred key is selected without prompting when the CLI is not interactive.
+func promptLocalSSHKey(ctx context.Context, keys []*localSSHKey) (*localSSHKey, error) {
+	if len(keys) == 1 {
+		addSSHKey, err := interactive.PromptBoolWithConfig(&interactive.PromptBoolConfig{
+			Ctx:          ctx,
+			Prompt:       "We found an SSH key in " + keys[0].ShortenedFilename + ". Do you want to add it to your Scaleway project?",
+			DefaultValue: true,
+		})
+		if err != nil || !addSSHKey {
+			return nil, err
+		}
+		return keys[0], nil
+	}
+
+	if !interactive.IsInteractive {
+		return keys[0], nil
+	}
+
+	choices := make([]string, 0, len(keys)+1)
+	for _, key := range keys {
+		choices = append(choices, key.ShortenedFilename)
+	}
+	choices = append(choices, "Do not add any SSH key")
+
+	prompt := interactive.ListPrompt{
+		Prompt:  "We found several SSH keys, which one do you want to add to your Scaleway project?",
+		Choices: choices,
+	}
+	index, err := prompt.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if index == len(keys) {
+		return nil, nil
+	}
+
+	return keys[index], nil
+}
@@ -3,10 +3,14 @@ package info
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
 	"github.com/scaleway/scaleway-cli/v2/internal/human"
+	"github.com/scaleway/scaleway-cli/v2/internal/redact"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
@@ -14,6 +18,8 @@ const (
 	defaultOrigin        = "default"
 	defaultProfileOrigin = "default profile"
 	unknownOrigin        = "unknown"
+	cliConfigOrigin      = "cli config file"
+	defaultAPIURL        = "https://api.scaleway.com"
 )
 
 func GetCommands() *core.Commands {
@@ -55,8 +61,11 @@ func infosRoot() *core.Command {
 	}
 
 	return &core.Command{
-		Groups:               []string{"config"},
-		Short:                `Get info about current settings`,
+		Groups: []string{"config"},
+		Short:  `Get info about current settings`,
+		Long: `Get info about the current settings and where each value comes from: a flag, an environment variable, the config file or a default value.
+It also shows the API URL, the user agent, the proxies used by the HTTP client and whether usage statistics and crash reports are sent.
+Secrets, including proxy credentials, are hidden unless show-secret=true is given.`,
 		Namespace:            "info",
 		AllowAnonymousClient: true,
 		ArgsType:             reflect.TypeOf(infoArgs{}),
@@ -83,6 +92,13 @@ func infosRoot() *core.Command {
 					defaultProjectID(ctx, config, profileName),
 					accessKey(ctx, config, profileName),
 					secretKey(ctx, config, profileName, req.ShowSecret),
+					apiURL(ctx, config, profileName),
+					userAgent(ctx),
+					proxy(ctx, "HTTP_PROXY", req.ShowSecret),
+					proxy(ctx, "HTTPS_PROXY", req.ShowSecret),
+					proxy(ctx, "NO_PROXY", req.ShowSecret),
+					sendUsage(ctx),
+					sendCrashReports(ctx),
 				},
 			}, nil
 		},
@@ -253,17 +269,6 @@ func accessKey(ctx context.Context, config *scw.Config, profileName string) *set
 	return setting
 }
 
-func hideSecretKey(k string) string {
-	switch {
-	case len(k) == 0:
-		return ""
-	case len(k) > 8:
-		return k[0:8] + "-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
-	default:
-		return "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
-	}
-}
-
 func secretKey(ctx context.Context, config *scw.Config, profileName string, showSecret bool) *setting {
 	setting := &setting{Key: "secret_key"}
 	switch {
@@ -286,7 +291,88 @@ func secretKey(ctx context.Context, config *scw.Config, profileName string, show
 		setting.Origin = unknownOrigin
 	}
 	if !showSecret {
-		setting.Value = hideSecretKey(setting.Value)
+		setting.Value = redact.Secret(setting.Value)
 	}
 	return setting
 }
+
+func apiURL(ctx context.Context, config *scw.Config, profileName string) *setting {
+	setting := &setting{Key: "api_url"}
+	switch {
+	// Environment variable check
+	case core.ExtractEnv(ctx, scw.ScwAPIURLEnv) != "":
+		setting.Value = core.ExtractEnv(ctx, scw.ScwAPIURLEnv)
+		setting.Origin = fmt.Sprintf("env (%s)", scw.ScwAPIURLEnv)
+	// Config file with profile name
+	case config != nil && config.Profiles[profileName] != nil && config.Profiles[profileName].APIURL != nil:
+		setting.Value = *config.Profiles[profileName].APIURL
+		setting.Origin = fmt.Sprintf("profile (%s)", profileName)
+	// Default config
+	case config != nil && config.Profile.APIURL != nil:
+		setting.Value = *config.Profile.APIURL
+		setting.Origin = defaultProfileOrigin
+	default:
+		setting.Value = defaultAPIURL
+		setting.Origin = defaultOrigin
+	}
+	return setting
+}
+
+// userAgent is the user agent of the CLI, the SDK adds its own version to it
+func userAgent(ctx context.Context) *setting {
+	return &setting{
+		Key:    "user_agent",
+		Value:  core.ExtractBuildInfo(ctx).GetUserAgent(),
+		Origin: defaultOrigin,
+	}
+}
+
+// proxy returns a proxy setting of the HTTP client, which reads the upper case environment variable first like Go does.
+// Credentials of the proxy URL are hidden unless showSecret is true.
+func proxy(ctx context.Context, envKey string, showSecret bool) *setting {
+	setting := &setting{Key: strings.ToLower(envKey)}
+	for _, key := range []string{envKey, strings.ToLower(envKey)} {
+		if value := core.ExtractEnv(ctx, key); value != "" {
+			setting.Value = value
+			setting.Origin = fmt.Sprintf("env (%s)", key)
+			break
+		}
+	}
+	if !showSecret {
+		setting.Value = hideProxyCredentials(setting.Value)
+	}
+	return setting
+}
+
+func hideProxyCredentials(proxyURL string) string {
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.User == nil {
+		return proxyURL
+	}
+	u.User = url.User("xxxx")
+	return u.String()
+}
+
+func sendUsage(ctx context.Context) *setting {
+	return cliConfigBool("send_usage", core.ExtractCliConfig(ctx).SendUsage)
+}
+
+func sendCrashReports(ctx context.Context) *setting {
+	return cliConfigBool("send_crash_reports", core.ExtractCliConfig(ctx).SendCrashReports)
+}
+
+// cliConfigBool returns a setting of the cli config file which is enabled when unset
+func cliConfigBool(key string, value *bool) *setting {
+	if value == nil {
+		return &setting{
+			Key:    key,
+			Value:  "true",
+			Origin: defaultOrigin,
+		}
+	}
+	return &setting{
+		Key:    key,
+		Value:  strconv.FormatBool(*value),
+		Origin: cliConfigOrigin,
+	}
+}
@@ -83,6 +83,34 @@ eval "$(scw autocomplete script shell=zsh)"
 			})(t)
 		})
 
+		t.Run("Zsh detected from SHELL", func(t *testing.T) {
+			core.Test(&core.TestConfig{
+				Commands:   initCLI.GetCommands(),
+				BeforeFunc: baseBeforeFunc(),
+				Cmd:        appendArgs("scw init install-autocomplete=true", defaultSettings),
+				Check: func(t *testing.T, ctx *core.CheckFuncCtx) {
+					if runtime.GOOS == windows {
+						// autocomplete installation is not yet supported on windows
+						return
+					}
+					homeDir := ctx.OverrideEnv["HOME"]
+					fileContent, err := os.ReadFile(path.Join(homeDir, ".zshrc"))
+					require.NoError(t, err)
+					require.Contains(t, string(fileContent), "shell=zsh")
+				},
+				TmpHomeDir: true,
+				OverrideEnv: map[string]string{
+					"SHELL": "/usr/local/bin/zsh",
+				},
+				PromptResponseMocks: []string{
+					// What type of shell are you using, the default value is used
+					"",
+					// Do you want to proceed with these changes? (Y/n):
+					"yes",
+				},
+			})(t)
+		})
+
 		t.Run("fish", func(t *testing.T) {
 			evalLine := `
 # Scaleway CLI autocomplete initialization.
@@ -134,4 +134,21 @@ func Test_InitSSH(t *testing.T) {
 			AfterFunc:  removeSSHKeyFromAccount(dummySSHKey),
 		})(t)
 	})
+
+	t.Run("WithSeveralLocalKeys", func(t *testing.T) {
+		dummySSHKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIIQE67HxSRicWd4ol7ntM2jdeD/qEehPJxK/3thmMiZg foobar@foobar"
+		otherSSHKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAICd8ZxAm9mXQsRHhQ5iADEJuO+Ai8EbXMI7TIlsh9jbE foobar@foobar"
+		core.Test(&core.TestConfig{
+			Commands: cmds,
+			BeforeFunc: core.BeforeFuncCombine(
+				baseBeforeFunc(),
+				setUpSSHKeyLocallyWithKeyName(otherSSHKey, "work.pub"),
+				setUpSSHKeyLocallyWithKeyName(dummySSHKey, "id_ed25519.pub"),
+			),
+			Cmd:        appendArgs("scw init with-ssh-key=true", defaultSettings),
+			Check:      core.TestCheckGolden(),
+			TmpHomeDir: true,
+			AfterFunc:  removeSSHKeyFromAccount(dummySSHKey),
+		})(t)
+	})
 }
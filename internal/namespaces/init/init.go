@@ -1,21 +1,35 @@
 package init
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"os/exec"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
+	"github.com/scaleway/scaleway-cli/v2/internal/account"
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
 	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+	"github.com/scaleway/scaleway-cli/v2/internal/keyring"
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/autocomplete"
 	iamcommands "github.com/scaleway/scaleway-cli/v2/internal/namespaces/iam/v1alpha1"
+	"github.com/scaleway/scaleway-cli/v2/internal/passphrase"
+	"github.com/scaleway/scaleway-cli/v2/internal/pkg/shlex"
+	"github.com/scaleway/scaleway-cli/v2/internal/redact"
 	"github.com/scaleway/scaleway-cli/v2/internal/terminal"
 	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/logger"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/scaleway-sdk-go/validation"
+	"github.com/skratchdot/open-golang/open"
 )
 
 /*
@@ -58,18 +72,41 @@ func GetCommands() *core.Commands {
 }
 
 type initArgs struct {
+	Profile        string
 	AccessKey      string
 	SecretKey      string
 	ProjectID      string
 	OrganizationID string
+	APIURL         string
 
 	Region              scw.Region
 	Zone                scw.Zone
 	SendTelemetry       *bool
+	SendUsage           *bool
+	SendCrashReports    *bool
 	WithSSHKey          *bool
 	InstallAutocomplete *bool
+	PreflightURL        string
+	ResultWebhook       string
+	Express             string
+	CredentialCommand   string
+	SecretKeyFile       string
+	Encrypt             bool
+	Keyring             bool
+	Browser             bool
+	Tutorial            bool
+	NonInteractive      bool
 }
 
+const (
+	// apiKeysURL is the page of the Scaleway console where API keys are created
+	apiKeysURL = "https://console.scaleway.com/iam/api-keys"
+
+	// defaultAPIURL is the API used by a profile without api-url, it is pinged before prompting for credentials
+	defaultAPIURL    = "https://api.scaleway.com"
+	preflightTimeout = 10 * time.Second
+)
+
 func initCommand() *core.Command {
 	return &core.Command{
 		Groups: []string{"config"},
@@ -80,11 +117,23 @@ Default path for configuration file is based on the following priority order:
 - $SCW_CONFIG_PATH
 - $XDG_CONFIG_HOME/scw/config.yaml
 - $HOME/.config/scw/config.yaml
-- $USERPROFILE/.config/scw/config.yaml`,
+- $USERPROFILE/.config/scw/config.yaml
+
+Several sources can be given for the secret-key, the first one available is used:
+
+- secret-key
+- credential-command
+- secret-key-file
+- scaleway-cli v1 config file ($HOME/.scwrc), after confirmation
+- prompt`,
 		Namespace:            "init",
 		AllowAnonymousClient: true,
 		ArgsType:             reflect.TypeOf(initArgs{}),
 		ArgSpecs: core.ArgSpecs{
+			{
+				Name:  "profile",
+				Short: "Name of the profile to initialize, same as the --profile flag",
+			},
 			{
 				Name:         "secret-key",
 				Short:        "Scaleway secret-key",
@@ -105,19 +154,72 @@ Default path for configuration file is based on the following priority order:
 				Short:        "Scaleway project ID",
 				ValidateFunc: core.ValidateProjectID(),
 			},
+			{
+				Name:         "api-url",
+				Short:        "URL of the API used by the profile, e.g. for a staging or a private deployment",
+				ValidateFunc: core.ValidateAPIURL(),
+			},
 			{
 				Name:  "send-telemetry",
-				Short: "Send usage statistics and diagnostics",
+				Short: "Send usage statistics and crash reports, same as setting both send-usage and send-crash-reports",
+			},
+			{
+				Name:  "send-usage",
+				Short: "Send usage statistics",
 			},
 			{
-				Name:    "with-ssh-key",
-				Short:   "Whether the SSH key for managing instances should be uploaded automatically",
-				Default: core.DefaultValueSetter("true"),
+				Name:  "send-crash-reports",
+				Short: "Send crash reports",
+			},
+			{
+				Name:  "with-ssh-key",
+				Short: "Whether the SSH key for managing instances should be uploaded automatically, true by default except in express and non-interactive modes",
 			},
 			{
 				Name:  "install-autocomplete",
 				Short: "Whether the autocomplete script should be installed during initialisation",
 			},
+			{
+				Name:  "preflight-url",
+				Short: "URL checked for connectivity before prompting for credentials, defaults to the API URL of the profile",
+			},
+			{
+				Name:         "express",
+				Short:        "Secret-key used for a setup without any prompt, everything else is derived from it or set to its default",
+				ValidateFunc: core.ValidateSecretKey(),
+			},
+			{
+				Name:  "credential-command",
+				Short: "Command whose output is used as secret-key, e.g. to read it from a password manager",
+			},
+			{
+				Name:  "secret-key-file",
+				Short: "File containing the secret-key",
+			},
+			{
+				Name:  "encrypt",
+				Short: "Store the secret-key encrypted with a passphrase, read from SCW_PASSPHRASE or prompted",
+			},
+			{
+				Name:  "browser",
+				Short: "Open the API keys page of the Scaleway console in a browser before prompting the secret-key",
+			},
+			{
+				Name:  "keyring",
+				Short: "Store the secret-key in the system keyring, the config file only holds a reference to it",
+			},
+			{
+				Name:  "non-interactive",
+				Short: "Never prompt, secret-key and organization-id are required, access-key is resolved from secret-key and other values default to their non-interactive value",
+			},
+			{
+				Name:  "tutorial",
+				Short: "Show the commands to create a first resource once initialized, only in interactive mode",
+			},
+			{
+				Name:  "result-webhook",
+				Short: "URL to which a summary of the init result is posted, secrets are never sent",
+			},
 			core.RegionArgSpec(scw.AllRegions...),
 			core.ZoneArgSpec(scw.AllZones...),
 		},
@@ -127,9 +229,52 @@ Default path for configuration file is based on the following priority order:
 				Command: "scw config",
 			},
 		},
+		PreValidateFunc: func(ctx context.Context, argsI interface{}) error {
+			return resolveSecretKey(ctx, argsI.(*initArgs))
+		},
 		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
 			args := argsI.(*initArgs)
 
+			// The result is sent whatever the step init fails at, the profile is read once it is known
+			if args.ResultWebhook != "" {
+				defer func() {
+					sendInitResult(ctx, args.ResultWebhook, &initResult{
+						Profile:        core.ExtractProfileName(ctx),
+						Zone:           args.Zone.String(),
+						Region:         args.Region.String(),
+						OrganizationID: args.OrganizationID,
+						Success:        e == nil,
+						Timestamp:      time.Now().UTC(),
+					})
+				}()
+			}
+
+			if args.Profile != "" {
+				profileFlag := core.ExtractProfileFlag(ctx)
+				if profileFlag != "" && profileFlag != args.Profile {
+					return nil, &core.CliError{
+						Err:  fmt.Errorf("profile %s conflicts with the --profile flag %s", args.Profile, profileFlag),
+						Hint: "Give the profile only once.",
+					}
+				}
+				core.InjectProfileName(ctx, args.Profile)
+			}
+
+			if args.Encrypt && args.Keyring {
+				return nil, &core.CliError{
+					Err:  fmt.Errorf("encrypt and keyring cannot be used together"),
+					Hint: "Choose one way to store the secret-key.",
+				}
+			}
+
+			// Credentials are checked against the API the profile will use
+			if args.APIURL != "" {
+				err := core.UseAPIURL(ctx, args.APIURL)
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			profileName := core.ExtractProfileName(ctx)
 			configPath := core.ExtractConfigPath(ctx)
 
@@ -141,12 +286,50 @@ Default path for configuration file is based on the following priority order:
 				return nil, err
 			}
 
-			err = promptProfileOverride(ctx, config, configPath, profileName)
-			if err != nil {
-				return nil, err
+			// An access-key resolved from the secret-key always matches it, an explicit one must be checked
+			accessKeyGiven := args.AccessKey != ""
+			if args.Express != "" {
+				err = applyExpressDefaults(ctx, args)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if args.NonInteractive {
+				err = applyNonInteractiveDefaults(ctx, args)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			// Check connectivity before asking the user anything about the credentials
+			if args.SecretKey == "" || args.AccessKey == "" {
+				err = checkPreflightURL(ctx, getPreflightURL(ctx, args))
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			// Long-time users can reuse the credentials of scaleway-cli v1
+			if args.SecretKey == "" && args.Express == "" && !args.NonInteractive {
+				err = importLegacyConfig(ctx, args)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			// Express and non-interactive modes never prompt, the profile is always overridden
+			if args.Express == "" && !args.NonInteractive {
+				err = promptProfileOverride(ctx, config, configPath, profileName)
+				if err != nil {
+					return nil, err
+				}
 			}
 
 			// Credentials
+			if args.SecretKey == "" && args.Browser {
+				openAPIKeysPage()
+			}
 			if args.SecretKey == "" {
 				args.SecretKey, err = promptSecretKey(ctx)
 				if err != nil {
@@ -161,6 +344,13 @@ Default path for configuration file is based on the following priority order:
 				}
 			}
 
+			if args.Express == "" || accessKeyGiven {
+				err = validateAPIKeyPair(ctx, args.AccessKey, args.SecretKey)
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			if args.OrganizationID == "" {
 				args.OrganizationID, err = promptOrganizationID(ctx)
 				if err != nil {
@@ -192,9 +382,21 @@ Default path for configuration file is based on the following priority order:
 				}
 			}
 
-			// Ask for send usage permission
-			if args.SendTelemetry == nil {
-				args.SendTelemetry, err = promptTelemetry(ctx)
+			// Ask for send usage and crash reports permissions, independently
+			if args.SendUsage == nil {
+				args.SendUsage = args.SendTelemetry
+			}
+			if args.SendCrashReports == nil {
+				args.SendCrashReports = args.SendTelemetry
+			}
+			if args.SendUsage == nil {
+				args.SendUsage, err = promptSendUsage(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if args.SendCrashReports == nil {
+				args.SendCrashReports, err = promptSendCrashReports(ctx)
 				if err != nil {
 					return nil, err
 				}
@@ -208,14 +410,38 @@ Default path for configuration file is based on the following priority order:
 				}
 			}
 
+			if args.WithSSHKey == nil {
+				args.WithSSHKey = scw.BoolPtr(true)
+			}
+
+			storedSecretKey := args.SecretKey
+			if args.Encrypt {
+				storedSecretKey, err = encryptSecretKey(ctx, args.SecretKey, !args.NonInteractive)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if args.Keyring {
+				storedSecretKey, err = keyring.Store(profileName, args.SecretKey)
+				if err != nil {
+					return nil, &core.CliError{
+						Err:  err,
+						Hint: "Run scw init without the keyring argument to store the secret-key in the config file.",
+					}
+				}
+			}
+
 			profile := &scw.Profile{
 				AccessKey:             &args.AccessKey,
-				SecretKey:             &args.SecretKey,
+				SecretKey:             &storedSecretKey,
 				DefaultZone:           scw.StringPtr(args.Zone.String()),
 				DefaultRegion:         scw.StringPtr(args.Region.String()),
 				DefaultOrganizationID: &args.OrganizationID,
 				DefaultProjectID:      &args.ProjectID, // An API key is always bound to a project.
 			}
+			if args.APIURL != "" {
+				profile.APIURL = &args.APIURL
+			}
 
 			// Save the profile as default or as a named profile
 			if profileName == scw.DefaultProfileName {
@@ -225,16 +451,31 @@ Default path for configuration file is based on the following priority order:
 				if config.Profiles == nil {
 					config.Profiles = make(map[string]*scw.Profile)
 				}
+				if _, exists := config.Profiles[profileName]; !exists {
+					warnDuplicateProfiles(ctx, config, profileName, profile)
+				}
 				config.Profiles[profileName] = profile
 			}
 
 			// Persist configuration on disk
-			interactive.Printf("Config saved at %s:\n%s\n", configPath, terminal.Style(fmt.Sprint(config), color.Faint))
+			interactive.Printf("Config saved at %s:\n%s\n", configPath, terminal.Style(redact.Config(config), color.Faint))
 			err = config.SaveTo(configPath)
 			if err != nil {
 				return nil, err
 			}
 
+			cliConfig := core.ExtractCliConfig(ctx)
+			cliConfig.SendUsage = args.SendUsage
+			cliConfig.SendCrashReports = args.SendCrashReports
+			if cliConfig.ProfilesUpdatedAt == nil {
+				cliConfig.ProfilesUpdatedAt = map[string]time.Time{}
+			}
+			cliConfig.ProfilesUpdatedAt[profileName] = time.Now().UTC()
+			err = cliConfig.Save()
+			if err != nil {
+				return nil, err
+			}
+
 			// Now that the config has been recorded we reload the client with the new config
 			err = core.ReloadClient(ctx)
 			if err != nil {
@@ -262,6 +503,12 @@ Default path for configuration file is based on the following priority order:
 				}
 			}
 
+			// Show how to get started, commands are never run
+			if args.Tutorial {
+				_, _ = interactive.Println()
+				_, _ = interactive.Println(tutorial(profileName, args.Zone))
+			}
+
 			_, _ = interactive.Println()
 
 			return &core.SuccessResult{
@@ -320,6 +567,194 @@ func getAPIKeyDefaultProjectID(ctx context.Context, accessKey string, secretKey
 	return apiKey.DefaultProjectID
 }
 
+// applyExpressDefaults fills every missing argument from the express secret-key so that init does not prompt anything.
+// The organization is resolved from the token bound to the secret-key, it must be given explicitly if it cannot be resolved.
+func applyExpressDefaults(ctx context.Context, args *initArgs) error {
+	args.SecretKey = args.Express
+
+	if args.AccessKey == "" || args.OrganizationID == "" {
+		token, err := account.GetAPIKey(ctx, args.SecretKey)
+		if err != nil {
+			return &core.CliError{
+				Err:  fmt.Errorf("failed to resolve secret-key: %w", err),
+				Hint: "Give access-key and organization-id explicitly or run init without express.",
+			}
+		}
+
+		if args.AccessKey == "" {
+			args.AccessKey = token.AccessKey
+		}
+		if args.OrganizationID == "" {
+			if token.OrganizationID == "" {
+				return &core.CliError{
+					Err:  fmt.Errorf("cannot resolve the organization bound to this secret-key"),
+					Hint: "Give it explicitly with organization-id=<organization-id>.",
+				}
+			}
+			args.OrganizationID = token.OrganizationID
+		}
+		if args.ProjectID == "" {
+			args.ProjectID = token.ProjectID
+		}
+	}
+
+	if args.ProjectID == "" {
+		args.ProjectID = args.OrganizationID
+	}
+	if args.Zone == "" {
+		args.Zone = scw.ZoneFrPar1
+	}
+	if args.SendUsage == nil {
+		args.SendUsage = scw.BoolPtr(false)
+	}
+	if args.SendCrashReports == nil {
+		args.SendCrashReports = scw.BoolPtr(false)
+	}
+	if args.InstallAutocomplete == nil {
+		args.InstallAutocomplete = scw.BoolPtr(false)
+	}
+	if args.WithSSHKey == nil {
+		args.WithSSHKey = scw.BoolPtr(false)
+	}
+
+	return nil
+}
+
+// applyNonInteractiveDefaults checks that every value that would be prompted is given
+// and sets all the other ones to their default, so that init never prompts.
+func applyNonInteractiveDefaults(ctx context.Context, args *initArgs) error {
+	missingArgs := []string(nil)
+	if args.SecretKey == "" {
+		missingArgs = append(missingArgs, "secret-key")
+	}
+	if args.OrganizationID == "" {
+		missingArgs = append(missingArgs, "organization-id")
+	}
+	if len(missingArgs) > 0 {
+		return &core.CliError{
+			Err:  fmt.Errorf("missing arguments for a non-interactive init: %s", strings.Join(missingArgs, ", ")),
+			Hint: "Give them explicitly, e.g. scw init non-interactive=true secret-key=<secret-key> organization-id=<organization-id>",
+		}
+	}
+
+	// The access-key would be prompted, it is resolved from the token bound to the secret-key instead
+	if args.AccessKey == "" {
+		token, err := account.GetAPIKey(ctx, args.SecretKey)
+		if err != nil {
+			return &core.CliError{
+				Err:  fmt.Errorf("failed to resolve the access-key of secret-key: %w", err),
+				Hint: "Give it explicitly with access-key=<access-key>.",
+			}
+		}
+		args.AccessKey = token.AccessKey
+	}
+
+	if args.ProjectID == "" {
+		args.ProjectID = getAPIKeyDefaultProjectID(ctx, args.AccessKey, args.SecretKey, args.OrganizationID)
+	}
+	if args.Zone == "" {
+		args.Zone = scw.ZoneFrPar1
+	}
+	if args.SendUsage == nil {
+		args.SendUsage = args.SendTelemetry
+	}
+	if args.SendUsage == nil {
+		args.SendUsage = scw.BoolPtr(false)
+	}
+	if args.SendCrashReports == nil {
+		args.SendCrashReports = args.SendTelemetry
+	}
+	if args.SendCrashReports == nil {
+		args.SendCrashReports = scw.BoolPtr(false)
+	}
+	if args.InstallAutocomplete == nil {
+		args.InstallAutocomplete = scw.BoolPtr(false)
+	}
+	// Uploading the SSH key asks for a confirmation
+	if args.WithSSHKey == nil {
+		args.WithSSHKey = scw.BoolPtr(false)
+	}
+
+	return nil
+}
+
+// getPreflightURL returns the URL checked for connectivity, the API the profile will use unless preflight-url is given.
+func getPreflightURL(ctx context.Context, args *initArgs) string {
+	switch {
+	case args.PreflightURL != "":
+		return args.PreflightURL
+	case args.APIURL != "":
+		return args.APIURL
+	case core.ExtractEnv(ctx, scw.ScwAPIURLEnv) != "":
+		return core.ExtractEnv(ctx, scw.ScwAPIURLEnv)
+	default:
+		return defaultAPIURL
+	}
+}
+
+// checkPreflightURL sends a HEAD request to preflightURL and returns an error if it cannot be reached.
+// Any HTTP response, whatever its status code, means the network is working.
+func checkPreflightURL(ctx context.Context, preflightURL string) error {
+	ctx, cancel := context.WithTimeout(ctx, preflightTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, preflightURL, nil)
+	if err != nil {
+		return fmt.Errorf("invalid preflight-url: %w", err)
+	}
+
+	resp, err := core.ExtractHTTPClient(ctx).Do(req)
+	if err != nil {
+		return &core.CliError{
+			Err:     fmt.Errorf("cannot reach %s", preflightURL),
+			Details: err.Error(),
+			Hint:    "Check your network connection or proxy settings before running init again.",
+		}
+	}
+	_ = resp.Body.Close()
+
+	return nil
+}
+
+// initResult is the summary of an init run sent to result-webhook.
+// It must never contain any credential.
+type initResult struct {
+	Profile        string    `json:"profile"`
+	Zone           string    `json:"zone,omitempty"`
+	Region         string    `json:"region,omitempty"`
+	OrganizationID string    `json:"organization_id,omitempty"`
+	Success        bool      `json:"success"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// sendInitResult posts result to webhookURL.
+// Init must not fail because of the webhook, errors are only logged as warnings.
+func sendInitResult(ctx context.Context, webhookURL string, result *initResult) {
+	body, err := json.Marshal(result)
+	if err != nil {
+		logger.Warningf("cannot marshal init result: %s", err)
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		logger.Warningf("cannot send init result to %s: %s", webhookURL, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := core.ExtractHTTPClient(ctx).Do(req)
+	if err != nil {
+		logger.Warningf("cannot send init result to %s: %s", webhookURL, err)
+		return
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		logger.Warningf("init result webhook %s answered with status %s", webhookURL, resp.Status)
+	}
+}
+
 // isHTTPCodeError returns true if err is an http error with code statusCode
 func isHTTPCodeError(err error, statusCode int) bool {
 	if err == nil {
@@ -333,6 +768,33 @@ func isHTTPCodeError(err error, statusCode int) bool {
 	return false
 }
 
+// validateAPIKeyPair makes sure the secret-key belongs to the access-key before they are saved in a profile.
+// A request authenticated with a mismatching pair is rejected with a 401, a 403 only means the
+// API key lacks the IAM permission to read itself, so the pair is valid.
+func validateAPIKeyPair(ctx context.Context, accessKey string, secretKey string) error {
+	api := iam.NewAPI(core.ExtractClient(ctx))
+	_, err := api.GetAPIKey(&iam.GetAPIKeyRequest{AccessKey: accessKey}, scw.WithAuthRequest(accessKey, secretKey), scw.WithContext(ctx))
+	switch {
+	case err == nil, is403Error(err):
+		return nil
+	case is401Error(err):
+		return &core.CliError{
+			Err:  fmt.Errorf("secret-key does not match access-key %s", accessKey),
+			Hint: "Check that both keys come from the same API key.",
+		}
+	default:
+		logger.Debugf("cannot validate API key pair: %s", err)
+		core.ExtractLogger(ctx).Warningf("could not check that the secret-key matches the access-key: %s\n", err)
+		return nil
+	}
+}
+
+// is401Error returns true if err is an HTTP 401 error
+func is401Error(err error) bool {
+	deniedAuthenticationError := &scw.DeniedAuthenticationError{}
+	return isHTTPCodeError(err, http.StatusUnauthorized) || errors.As(err, &deniedAuthenticationError)
+}
+
 // is403Error returns true if err is an HTTP 403 error
 func is403Error(err error) bool {
 	permissionsDeniedError := &scw.PermissionsDeniedError{}
@@ -355,3 +817,159 @@ const logo = `
   @@@@@@.         .@@@@            |___/ \___|  \_/\_/    \___||_||_|
      @@@@@@@@@@@@@@@@.
 `
+
+// warnDuplicateProfiles warns when other profiles of the config already use the same credentials as profile.
+func warnDuplicateProfiles(ctx context.Context, config *scw.Config, profileName string, profile *scw.Profile) {
+	sameKey := func(a, b *string) bool {
+		return a != nil && b != nil && *a != "" && *a == *b
+	}
+
+	profiles := map[string]*scw.Profile{
+		scw.DefaultProfileName: &config.Profile,
+	}
+	for name, p := range config.Profiles {
+		profiles[name] = p
+	}
+
+	duplicates := []string(nil)
+	for name, p := range profiles {
+		if name != profileName && (sameKey(p.AccessKey, profile.AccessKey) || sameKey(p.SecretKey, profile.SecretKey)) {
+			duplicates = append(duplicates, name)
+		}
+	}
+	if len(duplicates) == 0 {
+		return
+	}
+
+	sort.Strings(duplicates)
+	core.ExtractLogger(ctx).Warningf("profile %s uses the same credentials as the following profiles: %s\n", profileName, strings.Join(duplicates, ", "))
+}
+
+// openAPIKeysPage opens the page where API keys are created in a browser so the secret-key can be copied from it.
+// The URL is printed instead when no browser can be opened, the secret-key is then prompted as usual.
+func openAPIKeysPage() {
+	_, _ = interactive.Println()
+	err := open.Start(apiKeysURL)
+	if err != nil {
+		logger.Debugf("cannot open browser: %s", err)
+		_, _ = interactive.Printf("Could not open a browser, create an API key at %s\n", apiKeysURL)
+		return
+	}
+	_, _ = interactive.Printf("Create an API key in the opened page, then paste its secret-key below: %s\n", apiKeysURL)
+}
+
+// encryptSecretKey encrypts secretKey with a passphrase read from SCW_PASSPHRASE or prompted if allowed.
+// The passphrase is given to the platform so the client can be reloaded without prompting it again.
+func encryptSecretKey(ctx context.Context, secretKey string, canPrompt bool) (string, error) {
+	secret := core.ExtractEnv(ctx, passphrase.Env)
+	if secret == "" && !canPrompt {
+		return "", &core.CliError{
+			Err:  fmt.Errorf("a passphrase is required to encrypt the secret key"),
+			Hint: fmt.Sprintf("Give it with the %s environment variable.", passphrase.Env),
+		}
+	}
+	if secret == "" {
+		var err error
+		secret, err = promptPassphrase(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	encrypted, err := passphrase.Encrypt(secretKey, secret)
+	if err != nil {
+		return "", err
+	}
+
+	if p, ok := core.ExtractPlatform(ctx).(interface{ SetPassphrase(string) }); ok {
+		p.SetPassphrase(secret)
+	}
+
+	return encrypted, nil
+}
+
+// resolveSecretKey fills the secret-key from the first available source, in priority order:
+// the secret-key argument, the credential-command output and the secret-key-file content.
+// The secret-key is prompted later on if none of them is given.
+func resolveSecretKey(ctx context.Context, args *initArgs) error {
+	var err error
+	source := "secret-key"
+
+	switch {
+	case args.SecretKey != "":
+	case args.CredentialCommand != "":
+		source = "credential-command"
+		args.SecretKey, err = readCredentialCommand(ctx, args.CredentialCommand)
+	case args.SecretKeyFile != "":
+		source = "secret-key-file"
+		args.SecretKey, err = readSecretKeyFile(args.SecretKeyFile)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	core.ExtractLogger(ctx).Debugf("using secret-key from %s\n", source)
+	return nil
+}
+
+// readSecretKeyFile returns the secret-key stored in the given file.
+func readSecretKeyFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", &core.CliError{
+			Err:     fmt.Errorf("cannot read secret-key-file"),
+			Details: err.Error(),
+		}
+	}
+
+	secretKey := strings.TrimSpace(string(content))
+	if !validation.IsSecretKey(secretKey) {
+		return "", &core.CliError{
+			Err:  fmt.Errorf("secret-key-file %s does not contain a valid secret_key", path),
+			Hint: "The file should only contain the secret_key, formatted as: XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX.",
+		}
+	}
+
+	return secretKey, nil
+}
+
+// readCredentialCommand runs the given command and returns its output as a secret-key.
+// The command is not run through a shell, its output is never printed.
+func readCredentialCommand(ctx context.Context, command string) (string, error) {
+	// The command is split as a shell would, so arguments can be quoted
+	cmdArgs, err := shlex.Split(command)
+	if err != nil {
+		return "", &core.CliError{
+			Err:     fmt.Errorf("invalid credential-command"),
+			Details: err.Error(),
+		}
+	}
+	if len(cmdArgs) == 0 {
+		return "", &core.CliError{
+			Err: fmt.Errorf("credential-command is empty"),
+		}
+	}
+
+	cmd := exec.Command(cmdArgs[0], cmdArgs[1:]...) //nolint:gosec
+	core.ExtractLogger(ctx).Debugf("executing credential command: %s\n", cmdArgs[0])
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", &core.CliError{
+			Err:     fmt.Errorf("credential-command failed"),
+			Details: err.Error(),
+		}
+	}
+
+	secretKey := strings.TrimSpace(string(output))
+	if !validation.IsSecretKey(secretKey) {
+		return "", &core.CliError{
+			Err:  fmt.Errorf("credential-command did not output a valid secret_key"),
+			Hint: "The command should only print the secret_key, formatted as: XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX.",
+		}
+	}
+
+	return secretKey, nil
+}
@@ -1,14 +1,22 @@
 package init_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path"
 	"regexp"
 	"testing"
 
 	"github.com/alecthomas/assert"
+	cliConfig "github.com/scaleway/scaleway-cli/v2/internal/config"
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/keyring"
 	initCLI "github.com/scaleway/scaleway-cli/v2/internal/namespaces/init" // alias required to not collide with go init func
+	"github.com/scaleway/scaleway-cli/v2/internal/passphrase"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/stretchr/testify/require"
 )
@@ -37,6 +45,28 @@ func beforeFuncSaveConfig(config *scw.Config) core.BeforeFunc {
 	}
 }
 
+// beforeFuncStartWebhookServer starts a server receiving the init results, they are sent to the WebhookPayloads channel
+func beforeFuncStartWebhookServer() core.BeforeFunc {
+	return func(ctx *core.BeforeFuncCtx) error {
+		payloads := make(chan []byte, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			payloads <- body
+		}))
+		ctx.Meta["WebhookServer"] = server
+		ctx.Meta["WebhookURL"] = server.URL
+		ctx.Meta["WebhookPayloads"] = payloads
+		return nil
+	}
+}
+
+func afterFuncStopWebhookServer() core.AfterFunc {
+	return func(ctx *core.AfterFuncCtx) error {
+		ctx.Meta["WebhookServer"].(*httptest.Server).Close()
+		return nil
+	}
+}
+
 func TestInit(t *testing.T) {
 	defaultArgs := map[string]string{
 		"access-key":           "{{ .AccessKey }}",
@@ -100,6 +130,407 @@ func TestInit(t *testing.T) {
 		TmpHomeDir: true,
 	}))
 
+	t.Run("Mismatching key pair", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		BeforeFunc: baseBeforeFunc(),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init", defaultArgs),
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stderr), "secret-key does not match access-key")
+				_, err := os.Stat(path.Join(ctx.OverrideEnv["HOME"], ".config", "scw", "config.yaml"))
+				assert.True(t, os.IsNotExist(err))
+			},
+		),
+	}))
+
+	t.Run("Profile argument", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		BeforeFunc: baseBeforeFunc(),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init profile=foobar", defaultArgs),
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			checkConfig(func(t *testing.T, ctx *core.CheckFuncCtx, config *scw.Config) {
+				require.NotNil(t, config.Profiles["foobar"])
+				assert.Equal(t, ctx.Meta["SecretKey"], *config.Profiles["foobar"].SecretKey)
+			}),
+		),
+	}))
+
+	t.Run("Profile argument conflicts with flag", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		BeforeFunc: baseBeforeFunc(),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw -p other init profile=foobar", defaultArgs),
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stderr), "Profile foobar conflicts with the --profile flag other")
+			},
+		),
+	}))
+
+	t.Run("Preflight unreachable", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		TmpHomeDir: true,
+		// No credentials are given so init would prompt for them if the preflight check did not abort first.
+		Cmd: "scw init preflight-url=http://127.0.0.1:1 send-telemetry=false install-autocomplete=false with-ssh-key=false",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				require.Error(t, ctx.Err)
+				assert.Contains(t, ctx.Err.Error(), "cannot reach http://127.0.0.1:1")
+			},
+		),
+	}))
+
+	t.Run("Preflight uses api-url", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		TmpHomeDir: true,
+		Cmd:        "scw init api-url=http://127.0.0.1:1 send-telemetry=false install-autocomplete=false with-ssh-key=false",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				require.Error(t, ctx.Err)
+				assert.Contains(t, ctx.Err.Error(), "cannot reach http://127.0.0.1:1")
+			},
+		),
+	}))
+
+	t.Run("Express", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		BeforeFunc: baseBeforeFunc(),
+		TmpHomeDir: true,
+		Cmd:        "scw init express={{ .SecretKey }}",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			checkConfig(func(t *testing.T, ctx *core.CheckFuncCtx, config *scw.Config) {
+				assert.Equal(t, ctx.Meta["SecretKey"], *config.SecretKey)
+				assert.Equal(t, ctx.Meta["AccessKey"], *config.AccessKey)
+				assert.Equal(t, ctx.Meta["OrganizationID"], *config.DefaultOrganizationID)
+				assert.Equal(t, ctx.Meta["ProjectID"], *config.DefaultProjectID)
+				assert.Equal(t, "fr-par-1", *config.DefaultZone)
+				assert.Equal(t, "fr-par", *config.DefaultRegion)
+			}),
+		),
+	}))
+
+	t.Run("Express mismatching key pair", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		BeforeFunc: baseBeforeFunc(),
+		TmpHomeDir: true,
+		Cmd:        "scw init express={{ .SecretKey }} access-key={{ .AccessKey }} organization-id={{ .OrganizationID }}",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stderr), "secret-key does not match access-key")
+				_, err := os.Stat(path.Join(ctx.OverrideEnv["HOME"], ".config", "scw", "config.yaml"))
+				assert.True(t, os.IsNotExist(err))
+			},
+		),
+	}))
+
+	telemetryArgs := map[string]string{}
+	for k, v := range defaultArgs {
+		telemetryArgs[k] = v
+	}
+	delete(telemetryArgs, "send-telemetry")
+	telemetryArgs["send-usage"] = "true"
+	telemetryArgs["send-crash-reports"] = "false"
+
+	t.Run("Separate telemetry", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		BeforeFunc: baseBeforeFunc(),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init", telemetryArgs),
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				config, err := cliConfig.LoadConfig(path.Join(ctx.OverrideEnv["HOME"], ".config", "scw", cliConfig.DefaultConfigFileName))
+				require.NoError(t, err)
+				assert.True(t, config.IsTelemetryEnabled(cliConfig.TelemetryUsage))
+				assert.False(t, config.IsTelemetryEnabled(cliConfig.TelemetryCrashReport))
+			},
+		),
+	}))
+
+	t.Run("Encrypt", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		BeforeFunc: baseBeforeFunc(),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init encrypt=true", defaultArgs),
+		OverrideEnv: map[string]string{
+			passphrase.Env: "passphrase",
+		},
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			checkConfig(func(t *testing.T, ctx *core.CheckFuncCtx, config *scw.Config) {
+				assert.True(t, passphrase.IsEncrypted(*config.SecretKey))
+				secretKey, err := passphrase.Decrypt(*config.SecretKey, "passphrase")
+				require.NoError(t, err)
+				assert.Equal(t, ctx.Meta["SecretKey"], secretKey)
+			}),
+		),
+	}))
+
+	legacyArgs := map[string]string{}
+	for k, v := range defaultArgs {
+		legacyArgs[k] = v
+	}
+	delete(legacyArgs, "secret-key")
+	delete(legacyArgs, "organization-id")
+
+	t.Run("Legacy config import", core.Test(&core.TestConfig{
+		Commands: initCLI.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			baseBeforeFunc(),
+			func(ctx *core.BeforeFuncCtx) error {
+				legacyConfig := fmt.Sprintf(`{"organization":"%s","token":"%s","version":"v1.20"}`, ctx.Meta["OrganizationID"], ctx.Meta["SecretKey"])
+				return os.WriteFile(path.Join(ctx.OverrideEnv["HOME"], ".scwrc"), []byte(legacyConfig), 0o600)
+			},
+		),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init", legacyArgs),
+		PromptResponseMocks: []string{
+			// Do you want to import its credentials?
+			"yes",
+		},
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			checkConfig(func(t *testing.T, ctx *core.CheckFuncCtx, config *scw.Config) {
+				assert.Equal(t, ctx.Meta["SecretKey"], *config.SecretKey)
+				assert.Equal(t, ctx.Meta["OrganizationID"], *config.DefaultOrganizationID)
+			}),
+		),
+	}))
+
+	t.Run("Keyring", core.Test(&core.TestConfig{
+		Commands: initCLI.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			func(*core.BeforeFuncCtx) error {
+				keyring.MockInit()
+				return nil
+			},
+			baseBeforeFunc(),
+		),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init keyring=true", defaultArgs),
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			checkConfig(func(t *testing.T, ctx *core.CheckFuncCtx, config *scw.Config) {
+				assert.True(t, keyring.IsReference(*config.SecretKey))
+				secretKey, err := keyring.Resolve(*config.SecretKey)
+				require.NoError(t, err)
+				assert.Equal(t, ctx.Meta["SecretKey"], secretKey)
+			}),
+		),
+	}))
+
+	t.Run("Keyring and encrypt", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		BeforeFunc: baseBeforeFunc(),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init keyring=true encrypt=true", defaultArgs),
+		Check:      core.TestCheckExitCode(1),
+	}))
+
+	t.Run("Secret key flag wins over file", core.Test(&core.TestConfig{
+		Commands: initCLI.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			baseBeforeFunc(),
+			func(ctx *core.BeforeFuncCtx) error {
+				secretKeyFile := path.Join(ctx.OverrideEnv["HOME"], "secret-key")
+				ctx.Meta["SecretKeyFile"] = secretKeyFile
+				return os.WriteFile(secretKeyFile, []byte("44444444-4444-4444-4444-444444444444\n"), 0600)
+			},
+		),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init secret-key-file={{ .SecretKeyFile }}", defaultArgs),
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			checkConfig(func(t *testing.T, ctx *core.CheckFuncCtx, config *scw.Config) {
+				assert.Equal(t, ctx.Meta["SecretKey"], *config.SecretKey)
+			}),
+		),
+	}))
+
+	nonInteractiveArgs := map[string]string{
+		"access-key":      "{{ .AccessKey }}",
+		"secret-key":      "{{ .SecretKey }}",
+		"organization-id": "{{ .OrganizationID }}",
+		"project-id":      "{{ .ProjectID }}",
+		"non-interactive": "true",
+	}
+
+	t.Run("Non interactive", core.Test(&core.TestConfig{
+		Commands: initCLI.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			baseBeforeFunc(),
+			beforeFuncSaveConfig(&scw.Config{
+				Profile: scw.Profile{
+					AccessKey: scw.StringPtr("SCW22222222222222222"),
+					SecretKey: scw.StringPtr("22222222-2222-2222-2222-222222222222"),
+				},
+			}),
+		),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init", nonInteractiveArgs),
+		PromptResponseMocks: []string{
+			// Any prompt would consume this answer and cancel the init
+			"no",
+		},
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			checkConfig(func(t *testing.T, ctx *core.CheckFuncCtx, config *scw.Config) {
+				assert.Equal(t, ctx.Meta["SecretKey"], *config.SecretKey)
+				assert.Equal(t, "fr-par-1", *config.DefaultZone)
+			}),
+		),
+	}))
+
+	nonInteractiveWithoutAccessKeyArgs := map[string]string{}
+	for k, v := range nonInteractiveArgs {
+		nonInteractiveWithoutAccessKeyArgs[k] = v
+	}
+	delete(nonInteractiveWithoutAccessKeyArgs, "access-key")
+
+	t.Run("Non interactive without access key", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		BeforeFunc: baseBeforeFunc(),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init", nonInteractiveWithoutAccessKeyArgs),
+		PromptResponseMocks: []string{
+			// Any prompt would consume this answer and cancel the init
+			"no",
+		},
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			checkConfig(func(t *testing.T, ctx *core.CheckFuncCtx, config *scw.Config) {
+				assert.Equal(t, ctx.Meta["AccessKey"], *config.AccessKey)
+				assert.Equal(t, ctx.Meta["SecretKey"], *config.SecretKey)
+			}),
+		),
+	}))
+
+	t.Run("Non interactive missing arguments", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		TmpHomeDir: true,
+		Cmd:        "scw init non-interactive=true secret-key=11111111-1111-1111-1111-111111111111",
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stderr), "Missing arguments for a non-interactive init: organization-id")
+			},
+		),
+	}))
+
+	credentialCommandArgs := []string{"scw", "init", "credential-command=echo {{ .SecretKey }}"}
+	for k, v := range defaultArgs {
+		if k != "secret-key" {
+			credentialCommandArgs = append(credentialCommandArgs, k+"="+v)
+		}
+	}
+
+	t.Run("Credential command", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		BeforeFunc: baseBeforeFunc(),
+		TmpHomeDir: true,
+		Args:       credentialCommandArgs,
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.NotContains(t, string(ctx.Stdout), ctx.Meta["SecretKey"])
+			},
+			checkConfig(func(t *testing.T, ctx *core.CheckFuncCtx, config *scw.Config) {
+				assert.Equal(t, ctx.Meta["SecretKey"], *config.SecretKey)
+			}),
+		),
+	}))
+
+	quotedCredentialCommandArgs := append([]string{"scw", "init", `credential-command=sh -c "echo '{{ .SecretKey }}'"`}, credentialCommandArgs[3:]...)
+
+	t.Run("Credential command with quoted arguments", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		BeforeFunc: baseBeforeFunc(),
+		TmpHomeDir: true,
+		Args:       quotedCredentialCommandArgs,
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			checkConfig(func(t *testing.T, ctx *core.CheckFuncCtx, config *scw.Config) {
+				assert.Equal(t, ctx.Meta["SecretKey"], *config.SecretKey)
+			}),
+		),
+	}))
+
+	t.Run("Credential command invalid output", core.Test(&core.TestConfig{
+		Commands:   initCLI.GetCommands(),
+		TmpHomeDir: true,
+		Args:       []string{"scw", "init", "credential-command=echo not-a-secret-key"},
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				assert.Contains(t, string(ctx.Stderr), "credential-command did not output a valid secret_key")
+			},
+		),
+	}))
+
+	webhookSecretKey := "33333333-3333-3333-3333-333333333333"
+	webhookArgs := map[string]string{}
+	for k, v := range defaultArgs {
+		webhookArgs[k] = v
+	}
+	webhookArgs["secret-key"] = webhookSecretKey
+
+	t.Run("Result webhook", core.Test(&core.TestConfig{
+		Commands: initCLI.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			baseBeforeFunc(),
+			beforeFuncStartWebhookServer(),
+		),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init result-webhook={{ .WebhookURL }}", webhookArgs),
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				payload := <-ctx.Meta["WebhookPayloads"].(chan []byte)
+				assert.NotContains(t, string(payload), webhookSecretKey)
+
+				result := map[string]interface{}{}
+				require.NoError(t, json.Unmarshal(payload, &result))
+				assert.Equal(t, "default", result["profile"])
+				assert.Equal(t, "fr-par-1", result["zone"])
+				assert.Equal(t, "fr-par", result["region"])
+				assert.Equal(t, ctx.Meta["OrganizationID"], result["organization_id"])
+				assert.Equal(t, true, result["success"])
+				assert.NotEmpty(t, result["timestamp"])
+			},
+		),
+		AfterFunc: afterFuncStopWebhookServer(),
+	}))
+
+	t.Run("Result webhook on early failure", core.Test(&core.TestConfig{
+		Commands: initCLI.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			baseBeforeFunc(),
+			beforeFuncStartWebhookServer(),
+		),
+		TmpHomeDir: true,
+		Cmd:        appendArgs("scw init result-webhook={{ .WebhookURL }} keyring=true encrypt=true", webhookArgs),
+		Check: core.TestCheckCombine(
+			core.TestCheckExitCode(1),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				payload := <-ctx.Meta["WebhookPayloads"].(chan []byte)
+				result := map[string]interface{}{}
+				require.NoError(t, json.Unmarshal(payload, &result))
+				assert.Equal(t, "default", result["profile"])
+				assert.Equal(t, false, result["success"])
+			},
+		),
+		AfterFunc: afterFuncStopWebhookServer(),
+	}))
+
 	t.Run("CLIv2Config", func(t *testing.T) {
 		dummySecretKey := "22222222-2222-2222-2222-222222222222"
 		dummyAccessKey := "SCW22222222222222222"
@@ -178,6 +609,30 @@ func TestInit(t *testing.T) {
 			},
 		}))
 
+		t.Run("Duplicate credentials warning", core.Test(&core.TestConfig{
+			Commands: initCLI.GetCommands(),
+			BeforeFunc: core.BeforeFuncCombine(
+				baseBeforeFunc(),
+				func(ctx *core.BeforeFuncCtx) error {
+					secretKey := ctx.Meta["SecretKey"].(string)
+					return beforeFuncSaveConfig(&scw.Config{
+						Profile: scw.Profile{
+							AccessKey: &dummyAccessKey,
+							SecretKey: &secretKey,
+						},
+					})(ctx)
+				},
+			),
+			Cmd: appendArgs("scw -p duplicate init", defaultArgs),
+			Check: core.TestCheckCombine(
+				core.TestCheckExitCode(0),
+				func(t *testing.T, ctx *core.CheckFuncCtx) {
+					assert.Contains(t, ctx.LogBuffer, "profile duplicate uses the same credentials as the following profiles: default")
+				},
+			),
+			TmpHomeDir: true,
+		}))
+
 		t.Run("Prompt Overwrite for existing profile", core.Test(&core.TestConfig{
 			Commands: initCLI.GetCommands(),
 			BeforeFunc: core.BeforeFuncCombine(
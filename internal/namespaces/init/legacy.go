@@ -0,0 +1,73 @@
+package init
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/logger"
+	"github.com/scaleway/scaleway-sdk-go/validation"
+)
+
+// legacyConfigFileName is the config file of scaleway-cli v1, stored in the home directory
+const legacyConfigFileName = ".scwrc"
+
+// legacyConfig is the content of a scaleway-cli v1 config file.
+// The token is a secret key and the organization an organization ID.
+type legacyConfig struct {
+	Organization string `json:"organization"`
+	Token        string `json:"token"`
+}
+
+// loadLegacyConfig returns the scaleway-cli v1 config of the user with its path, or nil if there is no usable one.
+func loadLegacyConfig(ctx context.Context) (*legacyConfig, string) {
+	homeDir := core.ExtractUserHomeDir(ctx)
+	if homeDir == "" {
+		return nil, ""
+	}
+
+	legacyConfigPath := filepath.Join(homeDir, legacyConfigFileName)
+	content, err := os.ReadFile(legacyConfigPath)
+	if err != nil {
+		return nil, ""
+	}
+
+	config := &legacyConfig{}
+	err = json.Unmarshal(content, config)
+	if err != nil {
+		logger.Debugf("cannot parse legacy config %s: %s", legacyConfigPath, err)
+		return nil, ""
+	}
+
+	if !validation.IsSecretKey(config.Token) {
+		logger.Debugf("legacy config %s has no valid token", legacyConfigPath)
+		return nil, ""
+	}
+	if !validation.IsOrganizationID(config.Organization) {
+		config.Organization = ""
+	}
+
+	return config, legacyConfigPath
+}
+
+// importLegacyConfig offers to fill the missing secret-key and organization-id from a scaleway-cli v1 config.
+func importLegacyConfig(ctx context.Context, args *initArgs) error {
+	config, legacyConfigPath := loadLegacyConfig(ctx)
+	if config == nil {
+		return nil
+	}
+
+	doImport, err := promptLegacyConfigImport(ctx, legacyConfigPath)
+	if err != nil || !doImport {
+		return err
+	}
+
+	args.SecretKey = config.Token
+	if args.OrganizationID == "" {
+		args.OrganizationID = config.Organization
+	}
+
+	return nil
+}
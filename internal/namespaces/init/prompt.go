@@ -7,6 +7,8 @@ import (
 	"github.com/fatih/color"
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
 	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+	"github.com/scaleway/scaleway-cli/v2/internal/passphrase"
+	"github.com/scaleway/scaleway-cli/v2/internal/redact"
 	"github.com/scaleway/scaleway-cli/v2/internal/terminal"
 	"github.com/scaleway/scaleway-sdk-go/api/account/v3"
 	"github.com/scaleway/scaleway-sdk-go/logger"
@@ -92,15 +94,44 @@ func promptProjectID(ctx context.Context, accessKey string, secretKey string, or
 	return res.Projects[index].ID, nil
 }
 
-func promptTelemetry(ctx context.Context) (*bool, error) {
+// promptSendUsage asks whether usage statistics can be sent, it is never enabled when not interactive.
+func promptSendUsage(ctx context.Context) (*bool, error) {
+	if !interactive.IsInteractive {
+		return scw.BoolPtr(false), nil
+	}
+
+	_, _ = interactive.Println()
+	_, _ = interactive.PrintlnWithoutIndent(`
+					To improve this tool we rely on usage data.
+					Sending such data is optional and can be disabled at any time by running "scw config set send-usage=false".
+				`)
+
+	sendUsage, err := interactive.PromptBoolWithConfig(&interactive.PromptBoolConfig{
+		Prompt:       "Do you want to send usage statistics?",
+		DefaultValue: true,
+		Ctx:          ctx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scw.BoolPtr(sendUsage), nil
+}
+
+// promptSendCrashReports asks whether crash reports can be sent, it is never enabled when not interactive.
+func promptSendCrashReports(ctx context.Context) (*bool, error) {
+	if !interactive.IsInteractive {
+		return scw.BoolPtr(false), nil
+	}
+
 	_, _ = interactive.Println()
 	_, _ = interactive.PrintlnWithoutIndent(`
-					To improve this tool we rely on diagnostic and usage data.
-					Sending such data is optional and can be disabled at any time by running "scw config set send-telemetry=false".
+					When the CLI crashes, an anonymous report can be sent to help us fix the issue.
+					Sending such data is optional and can be disabled at any time by running "scw config set send-crash-reports=false".
 				`)
 
-	sendTelemetry, err := interactive.PromptBoolWithConfig(&interactive.PromptBoolConfig{
-		Prompt:       "Do you want to send usage statistics and diagnostics?",
+	sendCrashReports, err := interactive.PromptBoolWithConfig(&interactive.PromptBoolConfig{
+		Prompt:       "Do you want to send crash reports?",
 		DefaultValue: true,
 		Ctx:          ctx,
 	})
@@ -108,7 +139,38 @@ func promptTelemetry(ctx context.Context) (*bool, error) {
 		return nil, err
 	}
 
-	return scw.BoolPtr(sendTelemetry), nil
+	return scw.BoolPtr(sendCrashReports), nil
+}
+
+// promptPassphrase asks twice for the passphrase used to encrypt the secret key
+func promptPassphrase(ctx context.Context) (string, error) {
+	_, _ = interactive.Println()
+	secret, err := interactive.PromptPasswordWithConfig(&interactive.PromptPasswordConfig{
+		Ctx:    ctx,
+		Prompt: "Enter a passphrase to encrypt your secret key",
+	})
+	if err != nil {
+		return "", err
+	}
+	if secret == "" {
+		return "", &core.CliError{
+			Err:  fmt.Errorf("a passphrase is required to encrypt the secret key"),
+			Hint: fmt.Sprintf("It can also be given with the %s environment variable.", passphrase.Env),
+		}
+	}
+
+	confirmation, err := interactive.PromptPasswordWithConfig(&interactive.PromptPasswordConfig{
+		Ctx:    ctx,
+		Prompt: "Confirm the passphrase",
+	})
+	if err != nil {
+		return "", err
+	}
+	if confirmation != secret {
+		return "", fmt.Errorf("passphrases do not match")
+	}
+
+	return secret, nil
 }
 
 func promptAutocomplete(ctx context.Context) (*bool, error) {
@@ -129,6 +191,19 @@ func promptAutocomplete(ctx context.Context) (*bool, error) {
 	return scw.BoolPtr(installAutocomplete), nil
 }
 
+func promptLegacyConfigImport(ctx context.Context, legacyConfigPath string) (bool, error) {
+	_, _ = interactive.Println()
+	_, _ = interactive.PrintlnWithoutIndent(fmt.Sprintf(`
+					A configuration of scaleway-cli v1 was found in %s.
+				`, legacyConfigPath))
+
+	return interactive.PromptBoolWithConfig(&interactive.PromptBoolConfig{
+		Ctx:          ctx,
+		Prompt:       "Do you want to import its credentials?",
+		DefaultValue: true,
+	})
+}
+
 func promptSecretKey(ctx context.Context) (string, error) {
 	_, _ = interactive.Println()
 	secret, err := interactive.Readline(&interactive.ReadlineConfig{
@@ -231,7 +306,7 @@ func promptProfileOverride(ctx context.Context, config *scw.Config, configPath s
 	if !config.IsEmpty() && profileExists {
 		_, _ = interactive.PrintlnWithoutIndent(`
 					Current config is located at ` + configPath + `
-					` + terminal.Style(fmt.Sprint(profile), color.Faint) + `
+					` + terminal.Style(redact.Profile(profile), color.Faint) + `
 				`)
 		overrideConfig, err := interactive.PromptBoolWithConfig(&interactive.PromptBoolConfig{
 			Prompt:       fmt.Sprintf("Do you want to override the current profile (%s) ?", profileName),
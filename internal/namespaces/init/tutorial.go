@@ -0,0 +1,39 @@
+package init
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// tutorial returns a guided tour of the commands creating a first instance with the new profile.
+// Commands are only shown, never executed.
+func tutorial(profileName string, zone scw.Zone) string {
+	binary := "scw"
+	if profileName != scw.DefaultProfileName {
+		binary += " -p " + profileName
+	}
+
+	steps := []struct {
+		description string
+		command     string
+	}{
+		{"List the available instance types", "%s instance server-type list zone=%s"},
+		{"Create your first instance", "%s instance server create type=DEV1-S image=ubuntu_jammy zone=%s"},
+		{"List your instances", "%s instance server list zone=%s"},
+		{"Connect to your instance", "%s instance server ssh <server-id> zone=%s"},
+		{"Delete your instance, with its volumes and IP, once you are done", "%s instance server delete <server-id> with-volumes=all with-ip=true zone=%s"},
+	}
+
+	lines := []string{"Get started with your first instance, run the following commands:"}
+	for i, step := range steps {
+		lines = append(lines,
+			"",
+			fmt.Sprintf("%d. %s:", i+1, step.description),
+			"  "+fmt.Sprintf(step.command, binary, zone),
+		)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,22 @@
+package init
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func TestTutorial(t *testing.T) {
+	t.Run("Default profile", func(t *testing.T) {
+		output := tutorial(scw.DefaultProfileName, scw.ZoneNlAms1)
+		assert.Contains(t, output, "scw instance server create type=DEV1-S image=ubuntu_jammy zone=nl-ams-1")
+		assert.NotContains(t, output, "fr-par-1")
+		assert.NotContains(t, output, " -p ")
+	})
+
+	t.Run("Named profile", func(t *testing.T) {
+		output := tutorial("foobar", scw.ZoneFrPar2)
+		assert.Contains(t, output, "scw -p foobar instance server list zone=fr-par-2")
+	})
+}
@@ -56,6 +56,9 @@ func GetCommands() *core.Commands {
 		serverTerminateCommand(),
 		serverDetachVolumeCommand(),
 		serverSSHCommand(),
+		serverExecCommand(),
+		serverCopyCommand(),
+		serverTunnelCommand(),
 		serverActionCommand(),
 		serverStartCommand(),
 		serverStopCommand(),
@@ -63,6 +66,7 @@ func GetCommands() *core.Commands {
 		serverRebootCommand(),
 		serverEnableRoutedIPCommand(),
 		serverWaitCommand(),
+		serverWaitSSHCommand(),
 		serverAttachIPCommand(),
 		serverDetachIPCommand(),
 	))
@@ -70,6 +74,9 @@ func GetCommands() *core.Commands {
 	if cmdConsole := serverConsoleCommand(); cmdConsole != nil {
 		cmds.Add(cmdConsole)
 	}
+	if cmdLogs := serverLogsCommand(); cmdLogs != nil {
+		cmds.Add(cmdLogs)
+	}
 
 	//
 	// Server-Type
@@ -126,6 +133,7 @@ func GetCommands() *core.Commands {
 
 	cmds.MustFind("instance", "volume", "create").Override(volumeCreateBuilder)
 	cmds.MustFind("instance", "volume", "list").Override(volumeListBuilder)
+	cmds.MustFind("instance", "volume", "delete").Override(volumeDeleteBuilder)
 	cmds.Merge(core.NewCommands(
 		volumeWaitCommand(),
 	))
@@ -146,10 +154,13 @@ func GetCommands() *core.Commands {
 	cmds.MustFind("instance", "security-group", "get").Override(securityGroupGetBuilder)
 	cmds.MustFind("instance", "security-group", "list").Override(securityGroupListBuilder)
 	cmds.MustFind("instance", "security-group", "delete").Override(securityGroupDeleteBuilder)
+	cmds.MustFind("instance", "security-group", "delete-rule").Override(securityGroupDeleteRuleBuilder)
 
 	cmds.Merge(core.NewCommands(
 		securityGroupClearCommand(),
 		securityGroupEditCommand(),
+		securityGroupExportCommand(),
+		securityGroupImportCommand(),
 	))
 
 	//
@@ -175,6 +186,7 @@ func GetCommands() *core.Commands {
 	cmds.MustFind("instance", "user-data", "set").Override(userDataSetBuilder)
 	cmds.MustFind("instance", "user-data", "get").Override(userDataGetBuilder)
 	cmds.MustFind("instance", "user-data", "list").Override(userDataListBuilder)
+	cmds.Add(userDataDumpCommand())
 
 	//
 	// Private NICs
@@ -147,6 +147,16 @@ func imageCreateBuilder(c *core.Command) *core.Command {
 		return runner(ctx, request)
 	})
 
+	c.WaitFunc = func(ctx context.Context, argsI, respI interface{}) (interface{}, error) {
+		api := instance.NewAPI(core.ExtractClient(ctx))
+		return api.WaitForImage(&instance.WaitForImageRequest{
+			ImageID:       respI.(*instance.CreateImageResponse).Image.ID,
+			Zone:          argsI.(*customCreateImageRequest).Zone,
+			Timeout:       scw.TimeDurationPtr(imageActionTimeout),
+			RetryInterval: core.DefaultRetryInterval,
+		})
+	}
+
 	return c
 }
 
@@ -1,6 +1,7 @@
 package instance_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/instance/v1"
@@ -30,6 +31,45 @@ func Test_ImageCreate(t *testing.T) {
 		),
 	}))
 
+	t.Run("Create image and wait", core.Test(&core.TestConfig{
+		BeforeFunc: core.BeforeFuncCombine(
+			createServer("Server"),
+			core.ExecStoreBeforeCmd("Snapshot", `scw instance snapshot create volume-id={{ (index .Server.Volumes "0").ID }}`),
+		),
+		Commands: instance.GetCommands(),
+		Cmd:      "scw instance image create snapshot-id={{ .Snapshot.Snapshot.ID }} arch=x86_64 -w",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+		AfterFunc: core.AfterFuncCombine(
+			deleteServer("Server"),
+			core.ExecAfterCmd("scw instance image delete {{ .CmdResult.ID }}"),
+			deleteSnapshot("Snapshot"),
+		),
+	}))
+
+	t.Run("Create image and wait with json progress", core.Test(&core.TestConfig{
+		BeforeFunc: core.BeforeFuncCombine(
+			createServer("Server"),
+			core.ExecStoreBeforeCmd("Snapshot", `scw instance snapshot create volume-id={{ (index .Server.Volumes "0").ID }}`),
+		),
+		Commands: instance.GetCommands(),
+		Cmd:      "scw instance image create snapshot-id={{ .Snapshot.Snapshot.ID }} arch=x86_64 -w -o json",
+		Check: core.TestCheckCombine(
+			core.TestCheckGoldenAndReplacePatterns(core.GoldenReplacement{
+				Pattern:     regexp.MustCompile(`"time":"[^"]+"`),
+				Replacement: `"time":"2006-01-02T15:04:05Z"`,
+			}),
+			core.TestCheckExitCode(0),
+		),
+		AfterFunc: core.AfterFuncCombine(
+			deleteServer("Server"),
+			core.ExecAfterCmd("scw instance image delete {{ .CmdResult.ID }}"),
+			deleteSnapshot("Snapshot"),
+		),
+	}))
+
 	t.Run("Use additional snapshots", core.Test(&core.TestConfig{
 		Commands: instance.GetCommands(),
 		BeforeFunc: core.BeforeFuncCombine(
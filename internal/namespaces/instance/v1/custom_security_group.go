@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
 	"github.com/scaleway/scaleway-cli/v2/internal/editor"
 	"github.com/scaleway/scaleway-cli/v2/internal/human"
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
 	"github.com/scaleway/scaleway-cli/v2/internal/terminal"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/logger"
@@ -53,20 +54,13 @@ func marshalSecurityGroupRules(i interface{}, _ *human.MarshalOpt) (out string,
 	}
 
 	toHumanRule := func(rule *instance.SecurityGroupRule) *humanRule {
-		dest := "ALL"
-		if rule.DestPortFrom != nil {
-			dest = strconv.Itoa(int(*rule.DestPortFrom))
-		}
-		if rule.DestPortTo != nil {
-			dest += "-" + strconv.Itoa(int(*rule.DestPortTo))
-		}
 		return &humanRule{
 			ID:        rule.ID,
 			Direction: string(rule.Direction),
 			Protocol:  rule.Protocol,
 			Action:    rule.Action,
 			IPRange:   rule.IPRange.String(),
-			Dest:      dest,
+			Dest:      securityGroupRuleDest(rule),
 		}
 	}
 	humanRules := make([]*humanRule, len(rules))
@@ -78,6 +72,18 @@ func marshalSecurityGroupRules(i interface{}, _ *human.MarshalOpt) (out string,
 	return human.Marshal(humanRules, nil)
 }
 
+// securityGroupRuleDest returns the destination ports of a rule as a range, or ALL.
+func securityGroupRuleDest(rule *instance.SecurityGroupRule) string {
+	dest := "ALL"
+	if rule.DestPortFrom != nil {
+		dest = strconv.Itoa(int(*rule.DestPortFrom))
+	}
+	if rule.DestPortTo != nil {
+		dest += "-" + strconv.Itoa(int(*rule.DestPortTo))
+	}
+	return dest
+}
+
 // MarshalHuman marshals a customSecurityGroupResponse.
 func (sg *customSecurityGroupResponse) MarshalHuman() (out string, err error) {
 	humanSecurityGroup := struct {
@@ -305,6 +311,70 @@ func securityGroupDeleteBuilder(c *core.Command) *core.Command {
 	return c
 }
 
+// securityGroupDeleteRuleBuilder lets interactive users select several rules to delete when no rule is given.
+func securityGroupDeleteRuleBuilder(c *core.Command) *core.Command {
+	c.ArgSpecs.GetByName("security-group-rule-id").Required = false
+	c.ArgSpecs.GetByName("security-group-rule-id").Short = "Rule to delete, rules are selected from a list when it is missing in interactive mode"
+
+	c.AddInterceptors(func(ctx context.Context, argsI interface{}, runner core.CommandRunner) (interface{}, error) {
+		req := argsI.(*instance.DeleteSecurityGroupRuleRequest)
+		if req.SecurityGroupRuleID != "" {
+			return runner(ctx, req)
+		}
+		if !interactive.IsInteractive {
+			return nil, core.MissingRequiredArgumentError("security-group-rule-id")
+		}
+
+		api := instance.NewAPI(core.ExtractClient(ctx))
+		resp, err := api.ListSecurityGroupRules(&instance.ListSecurityGroupRulesRequest{
+			Zone:            req.Zone,
+			SecurityGroupID: req.SecurityGroupID,
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		// Default rules cannot be deleted
+		rules := []*instance.SecurityGroupRule(nil)
+		choices := []string(nil)
+		for _, rule := range resp.Rules {
+			if !rule.Editable {
+				continue
+			}
+			rules = append(rules, rule)
+			choices = append(choices, fmt.Sprintf("%s %s %s %s %s (%s)", rule.Direction, rule.Action, rule.Protocol, rule.IPRange.String(), securityGroupRuleDest(rule), rule.ID))
+		}
+		if len(rules) == 0 {
+			return nil, fmt.Errorf("security group %s has no rule to delete", req.SecurityGroupID)
+		}
+
+		indexes, err := interactive.PromptMultiSelectWithConfig(&interactive.PromptMultiSelectConfig{
+			Ctx:     ctx,
+			Prompt:  "Select the rules to delete",
+			Choices: choices,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(indexes) == 0 {
+			return nil, fmt.Errorf("no rule selected")
+		}
+
+		var res interface{}
+		for _, i := range indexes {
+			ruleReq := *req
+			ruleReq.SecurityGroupRuleID = rules[i].ID
+			res, err = runner(ctx, &ruleReq)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	})
+
+	return c
+}
+
 //
 // Commands
 //
@@ -0,0 +1,306 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/editor"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// securityGroupRulesFile is the content of a file describing the editable rules of a security group.
+// Rules are identified by their content, which allows a file to be applied to any security group.
+type securityGroupRulesFile struct {
+	Rules []*securityGroupRulesFileRule `json:"rules"`
+}
+
+type securityGroupRulesFileRule struct {
+	Action       instance.SecurityGroupRuleAction    `json:"action"`
+	Direction    instance.SecurityGroupRuleDirection `json:"direction"`
+	Protocol     instance.SecurityGroupRuleProtocol  `json:"protocol"`
+	IPRange      scw.IPNet                           `json:"ip_range"`
+	DestPortFrom *uint32                             `json:"dest_port_from,omitempty"`
+	DestPortTo   *uint32                             `json:"dest_port_to,omitempty"`
+}
+
+// key returns a string identifying a rule by its content
+func (r *securityGroupRulesFileRule) key() string {
+	ports := ""
+	if r.DestPortFrom != nil {
+		ports = strconv.FormatUint(uint64(*r.DestPortFrom), 10)
+		// A range of a single port is the same rule as this port
+		if r.DestPortTo != nil && *r.DestPortTo != *r.DestPortFrom {
+			ports += "-" + strconv.FormatUint(uint64(*r.DestPortTo), 10)
+		}
+	}
+	return fmt.Sprintf("%s/%s/%s/%s/%s", r.Direction, r.Action, r.Protocol, r.IPRange.String(), ports)
+}
+
+func newSecurityGroupRulesFileRule(rule *instance.SecurityGroupRule) *securityGroupRulesFileRule {
+	return &securityGroupRulesFileRule{
+		Action:       rule.Action,
+		Direction:    rule.Direction,
+		Protocol:     rule.Protocol,
+		IPRange:      rule.IPRange,
+		DestPortFrom: rule.DestPortFrom,
+		DestPortTo:   rule.DestPortTo,
+	}
+}
+
+// listEditableSecurityGroupRules returns the rules of a security group that are not managed by its default security
+func listEditableSecurityGroupRules(ctx context.Context, api *instance.API, zone scw.Zone, securityGroupID string) ([]*instance.SecurityGroupRule, error) {
+	rules, err := api.ListSecurityGroupRules(&instance.ListSecurityGroupRulesRequest{
+		Zone:            zone,
+		SecurityGroupID: securityGroupID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list security-group rules: %w", err)
+	}
+
+	editableRules := []*instance.SecurityGroupRule(nil)
+	for _, rule := range rules.Rules {
+		if rule.Editable {
+			editableRules = append(editableRules, rule)
+		}
+	}
+	return editableRules, nil
+}
+
+//
+// Commands
+//
+
+type instanceSecurityGroupExportArgs struct {
+	Zone            scw.Zone
+	SecurityGroupID string
+	Mode            editor.MarshalMode
+}
+
+func securityGroupExportCommand() *core.Command {
+	return &core.Command{
+		Short: `Export the rules of a security group`,
+		Long: `Export the editable rules of a security group to a file that can be applied to a security group with scw instance security-group import.
+Rules managed by the default security of the security group are not exported.`,
+		Namespace: "instance",
+		Resource:  "security-group",
+		Verb:      "export",
+		ArgsType:  reflect.TypeOf(instanceSecurityGroupExportArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:       "security-group-id",
+				Short:      `ID of the security group to export`,
+				Required:   true,
+				Positional: true,
+			},
+			{
+				Name:       "mode",
+				Short:      "Format of the exported rules",
+				Default:    core.DefaultValueSetter(editor.MarshalModeDefault),
+				EnumValues: editor.MarshalModeEnum,
+			},
+			core.ZoneArgSpec((*instance.API)(nil).Zones()...),
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Export the rules of a security group to a file",
+				Raw:   "scw instance security-group export 11111111-1111-1111-1111-111111111111 > rules.yaml",
+			},
+		},
+		SeeAlsos: []*core.SeeAlso{
+			{
+				Short:   "Apply rules to a security group",
+				Command: "scw instance security-group import",
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			args := argsI.(*instanceSecurityGroupExportArgs)
+
+			api := instance.NewAPI(core.ExtractClient(ctx))
+			rules, err := listEditableSecurityGroupRules(ctx, api, args.Zone, args.SecurityGroupID)
+			if err != nil {
+				return nil, err
+			}
+
+			file := &securityGroupRulesFile{
+				Rules: make([]*securityGroupRulesFileRule, 0, len(rules)),
+			}
+			for _, rule := range rules {
+				file.Rules = append(file.Rules, newSecurityGroupRulesFileRule(rule))
+			}
+
+			var content []byte
+			switch args.Mode {
+			case editor.MarshalModeJSON:
+				content, err = json.MarshalIndent(file, "", "  ")
+				content = append(content, '\n')
+			default:
+				content, err = yaml.Marshal(file)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			return core.RawResult(content), nil
+		},
+	}
+}
+
+type instanceSecurityGroupImportArgs struct {
+	Zone            scw.Zone
+	SecurityGroupID string
+	Rules           string
+}
+
+// securityGroupRuleChange is a rule added or deleted when importing rules in a security group
+type securityGroupRuleChange struct {
+	Change       string                              `json:"change"`
+	Action       instance.SecurityGroupRuleAction    `json:"action"`
+	Direction    instance.SecurityGroupRuleDirection `json:"direction"`
+	Protocol     instance.SecurityGroupRuleProtocol  `json:"protocol"`
+	IPRange      scw.IPNet                           `json:"ip_range"`
+	DestPortFrom *uint32                             `json:"dest_port_from"`
+	DestPortTo   *uint32                             `json:"dest_port_to"`
+}
+
+func newSecurityGroupRuleChange(change string, rule *securityGroupRulesFileRule) *securityGroupRuleChange {
+	return &securityGroupRuleChange{
+		Change:       change,
+		Action:       rule.Action,
+		Direction:    rule.Direction,
+		Protocol:     rule.Protocol,
+		IPRange:      rule.IPRange,
+		DestPortFrom: rule.DestPortFrom,
+		DestPortTo:   rule.DestPortTo,
+	}
+}
+
+func securityGroupImportCommand() *core.Command {
+	return &core.Command{
+		Short: `Import the rules of a security group`,
+		Long: `Apply rules exported with scw instance security-group export to a security group.
+The rules of the file are compared to the editable rules of the security group, only the rules that differ are deleted or created.
+Importing the same file twice does not change anything, which allows to version the rules of security groups.`,
+		Namespace: "instance",
+		Resource:  "security-group",
+		Verb:      "import",
+		ArgsType:  reflect.TypeOf(instanceSecurityGroupImportArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:       "security-group-id",
+				Short:      `ID of the security group to apply the rules to`,
+				Required:   true,
+				Positional: true,
+			},
+			{
+				Name:        "rules",
+				Short:       "Rules to apply in YAML or JSON, as exported by scw instance security-group export",
+				Required:    true,
+				CanLoadFile: true,
+			},
+			core.ZoneArgSpec((*instance.API)(nil).Zones()...),
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Apply the rules of a file to a security group",
+				Raw:   "scw instance security-group import 11111111-1111-1111-1111-111111111111 rules=@rules.yaml",
+			},
+		},
+		SeeAlsos: []*core.SeeAlso{
+			{
+				Short:   "Export the rules of a security group",
+				Command: "scw instance security-group export",
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			args := argsI.(*instanceSecurityGroupImportArgs)
+
+			// YAML being a superset of JSON, both formats are handled
+			file := &securityGroupRulesFile{}
+			err := yaml.Unmarshal([]byte(args.Rules), file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse rules: %w", err)
+			}
+			for index, rule := range file.Rules {
+				if rule.Action == "" || rule.Direction == "" || rule.Protocol == "" || rule.IPRange.IP == nil {
+					return nil, &core.CliError{
+						Err:  fmt.Errorf("rule %d is incomplete", index),
+						Hint: "A rule requires an action, a direction, a protocol and an ip_range",
+					}
+				}
+			}
+
+			api := instance.NewAPI(core.ExtractClient(ctx))
+			currentRules, err := listEditableSecurityGroupRules(ctx, api, args.Zone, args.SecurityGroupID)
+			if err != nil {
+				return nil, err
+			}
+
+			// Current rules matching a rule of the file are kept, the other ones are deleted
+			currentRulesByKey := map[string][]*instance.SecurityGroupRule{}
+			for _, rule := range currentRules {
+				key := newSecurityGroupRulesFileRule(rule).key()
+				currentRulesByKey[key] = append(currentRulesByKey[key], rule)
+			}
+			rulesToCreate := []*securityGroupRulesFileRule(nil)
+			for _, rule := range file.Rules {
+				key := rule.key()
+				if len(currentRulesByKey[key]) > 0 {
+					currentRulesByKey[key] = currentRulesByKey[key][1:]
+					continue
+				}
+				rulesToCreate = append(rulesToCreate, rule)
+			}
+
+			changes := []*securityGroupRuleChange{}
+			for _, rule := range currentRules {
+				key := newSecurityGroupRulesFileRule(rule).key()
+				if !containsSecurityGroupRule(currentRulesByKey[key], rule) {
+					continue
+				}
+				err := api.DeleteSecurityGroupRule(&instance.DeleteSecurityGroupRuleRequest{
+					Zone:                args.Zone,
+					SecurityGroupID:     args.SecurityGroupID,
+					SecurityGroupRuleID: rule.ID,
+				}, scw.WithContext(ctx))
+				if err != nil {
+					return nil, err
+				}
+				changes = append(changes, newSecurityGroupRuleChange("delete", newSecurityGroupRulesFileRule(rule)))
+			}
+
+			for _, rule := range rulesToCreate {
+				_, err := api.CreateSecurityGroupRule(&instance.CreateSecurityGroupRuleRequest{
+					Zone:            args.Zone,
+					SecurityGroupID: args.SecurityGroupID,
+					Action:          rule.Action,
+					Direction:       rule.Direction,
+					Protocol:        rule.Protocol,
+					IPRange:         rule.IPRange,
+					DestPortFrom:    rule.DestPortFrom,
+					DestPortTo:      rule.DestPortTo,
+				}, scw.WithContext(ctx))
+				if err != nil {
+					return nil, err
+				}
+				changes = append(changes, newSecurityGroupRuleChange("create", rule))
+			}
+
+			return changes, nil
+		},
+	}
+}
+
+func containsSecurityGroupRule(rules []*instance.SecurityGroupRule, rule *instance.SecurityGroupRule) bool {
+	for _, r := range rules {
+		if r.ID == rule.ID {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package instance_test
 
 import (
+	"os"
 	"testing"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/instance/v1"
@@ -22,3 +23,61 @@ func Test_SecurityGroupGet(t *testing.T) {
 		AfterFunc: deleteSecurityGroup("SecurityGroup"),
 	}))
 }
+
+func Test_SecurityGroupExport(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			createSecurityGroup("SecurityGroup"),
+		),
+		Cmd: "scw instance security-group export {{ .SecurityGroup.ID }}",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+		AfterFunc: deleteSecurityGroup("SecurityGroup"),
+	}))
+}
+
+func Test_SecurityGroupImport(t *testing.T) {
+	rules := `rules:
+- action: accept
+  direction: inbound
+  protocol: TCP
+  ip_range: 0.0.0.0/0
+  dest_port_from: 80
+- action: accept
+  direction: inbound
+  protocol: TCP
+  ip_range: 0.0.0.0/0
+  dest_port_from: 443
+`
+
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			createSecurityGroup("SecurityGroup"),
+			func(ctx *core.BeforeFuncCtx) error {
+				file, err := os.CreateTemp("", "rules")
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				_, err = file.WriteString(rules)
+				ctx.Meta["filePath"] = file.Name()
+				return err
+			},
+		),
+		Cmd: "scw instance security-group import {{ .SecurityGroup.ID }} rules=@{{ .filePath }}",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+		AfterFunc: core.AfterFuncCombine(
+			deleteSecurityGroup("SecurityGroup"),
+			func(ctx *core.AfterFuncCtx) error {
+				return os.Remove(ctx.Meta["filePath"].(string))
+			},
+		),
+	}))
+}
@@ -239,6 +239,14 @@ func serverUpdateBuilder(c *core.Command) *core.Command {
 			return nil, err
 		}
 
+		// The API only allows to move a stopped server in or out of a placement group
+		if customRequest.PlacementGroupID != nil && getServerResponse.Server.State != instance.ServerStateStopped {
+			return nil, &core.CliError{
+				Err:  fmt.Errorf("server %s must be stopped to change its placement group", customRequest.ServerID),
+				Hint: fmt.Sprintf("Stop the instance with: %s instance server stop %s --wait", core.ExtractBinaryName(ctx), customRequest.ServerID),
+			}
+		}
+
 		switch {
 		case customRequest.IP == nil:
 			// ip is not set
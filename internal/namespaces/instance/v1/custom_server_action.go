@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
 	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
@@ -19,10 +20,32 @@ func serverStartCommand() *core.Command {
 		Namespace: "instance",
 		Resource:  "server",
 		Verb:      "start",
-		ArgsType:  reflect.TypeOf(instanceUniqueActionRequest{}),
-		Run:       getRunServerAction(instance.ServerActionPoweron),
-		WaitFunc:  waitForServerFunc(),
-		ArgSpecs:  serverActionArgSpecs,
+		ArgsType:  reflect.TypeOf(instanceStartServerRequest{}),
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			args := argsI.(*instanceStartServerRequest)
+
+			result, err := getRunServerAction(instance.ServerActionPoweron)(ctx, args.uniqueActionRequest())
+			if err != nil || !args.WaitSSH {
+				return result, err
+			}
+			return waitForServerSSH(ctx, args.Zone, args.ServerID, sshDefaultPort, serverActionTimeout)
+		},
+		WaitFunc: func(ctx context.Context, argsI, respI interface{}) (interface{}, error) {
+			return waitForServerFunc()(ctx, argsI.(*instanceStartServerRequest).uniqueActionRequest(), respI)
+		},
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:       "server-id",
+				Short:      `ID of the server affected by the action.`,
+				Required:   true,
+				Positional: true,
+			},
+			{
+				Name:  "wait-ssh",
+				Short: "Wait for the server to accept SSH connections on port 22",
+			},
+			core.ZoneArgSpec((*instance.API)(nil).Zones()...),
+		},
 		Examples: []*core.Example{
 			{
 				Short:    "Start a server in the default zone with a given id",
@@ -32,6 +55,10 @@ func serverStartCommand() *core.Command {
 				Short:    "Start a server in fr-par-1 zone with a given id",
 				ArgsJSON: `{"zone":"fr-par-1", "server_id": "11111111-1111-1111-1111-111111111111"}`,
 			},
+			{
+				Short:    "Start a server and wait for it to accept SSH connections",
+				ArgsJSON: `{"server_id": "11111111-1111-1111-1111-111111111111", "wait_ssh": true}`,
+			},
 		},
 	}
 }
@@ -145,6 +172,7 @@ This command:
   - creates an image based on all these snapshots.
 
 Once your image is ready you will be able to create a new server based on this image.
+The name of the image can contain {server}, replaced by the name of the server, and {date}, replaced by the current date.
 `,
 		Namespace: "instance",
 		Resource:  "server",
@@ -163,11 +191,12 @@ Once your image is ready you will be able to create a new server based on this i
 				return nil, err
 			}
 
+			name := backupName(args.Name, server.Server.Name, time.Now())
 			req := &instance.ServerActionRequest{
 				Zone:     args.Zone,
 				ServerID: args.ServerID,
 				Action:   instance.ServerActionBackup,
-				Name:     &args.Name,
+				Name:     &name,
 				Volumes:  map[string]*instance.ServerActionRequestVolumeBackupTemplate{},
 			}
 			for _, v := range server.Server.Volumes {
@@ -213,7 +242,7 @@ Once your image is ready you will be able to create a new server based on this i
 			},
 			{
 				Name:    "name",
-				Short:   `Name of your backup.`,
+				Short:   `Name of your backup, {server} and {date} are replaced by the name of the server and the current date.`,
 				Default: core.RandomValueGenerator("backup"),
 			},
 			{
@@ -227,10 +256,22 @@ Once your image is ready you will be able to create a new server based on this i
 				Short:    "Create a new image based on a server",
 				ArgsJSON: `{"server_id": "11111111-1111-1111-1111-111111111111"}`,
 			},
+			{
+				Short: "Create a new image named after the server and the current date, and wait for it to be available",
+				Raw:   "scw instance server backup 11111111-1111-1111-1111-111111111111 name={server}-{date} --wait",
+			},
 		},
 	}
 }
 
+// backupName replaces the placeholders of the name of a backup
+func backupName(template string, serverName string, now time.Time) string {
+	return strings.NewReplacer(
+		"{server}", serverName,
+		"{date}", now.Format("2006-01-02"),
+	).Replace(template)
+}
+
 type customTerminateServerRequest struct {
 	Zone      scw.Zone
 	ServerID  string
@@ -248,12 +289,31 @@ const (
 
 func serverTerminateCommand() *core.Command {
 	return &core.Command{
-		Short:     `Terminate server`,
-		Long:      `Terminates a server with the given ID and all of its volumes.`,
-		Namespace: "instance",
-		Verb:      "terminate",
-		Resource:  "server",
-		ArgsType:  reflect.TypeOf(customTerminateServerRequest{}),
+		Short:       `Terminate server`,
+		Long:        `Terminates a server with the given ID and all of its volumes.`,
+		Namespace:   "instance",
+		Verb:        "terminate",
+		Resource:    "server",
+		ArgsType:    reflect.TypeOf(customTerminateServerRequest{}),
+		Destructive: true,
+		DangerousResourceNameFunc: func(ctx context.Context, argsI interface{}) (string, error) {
+			terminateServerArgs := argsI.(*customTerminateServerRequest)
+			server, err := instance.NewAPI(core.ExtractClient(ctx)).GetServer(&instance.GetServerRequest{
+				Zone:     terminateServerArgs.Zone,
+				ServerID: terminateServerArgs.ServerID,
+			})
+			if err != nil {
+				return "", err
+			}
+
+			// Only local volumes are always lost, block volumes may be kept with --with-block
+			for _, volume := range server.Server.Volumes {
+				if volume.VolumeType == instance.VolumeServerVolumeTypeLSSD {
+					return server.Server.Name, nil
+				}
+			}
+			return "", nil
+		},
 		ArgSpecs: core.ArgSpecs{
 			{
 				Name:       "server-id",
@@ -435,6 +495,19 @@ type instanceUniqueActionRequest struct {
 	ServerID string
 }
 
+type instanceStartServerRequest struct {
+	Zone     scw.Zone
+	ServerID string
+	WaitSSH  bool
+}
+
+func (r *instanceStartServerRequest) uniqueActionRequest() *instanceUniqueActionRequest {
+	return &instanceUniqueActionRequest{
+		Zone:     r.Zone,
+		ServerID: r.ServerID,
+	}
+}
+
 var serverActionArgSpecs = core.ArgSpecs{
 	{
 		Name:       "server-id",
@@ -112,6 +112,25 @@ func Test_ServerBackup(t *testing.T) {
 			core.ExecAfterCmd("scw instance server delete {{ .Server.ID }} with-ip=true with-volumes=local"),
 		),
 	}))
+
+	t.Run("with name template", core.Test(&core.TestConfig{
+		Commands:   instance.GetCommands(),
+		BeforeFunc: core.ExecStoreBeforeCmd("Server", "scw instance server create stopped=true image=ubuntu-jammy"),
+		Cmd:        `scw instance server backup {{ .Server.ID }} name={server}-backup`,
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				server := ctx.Meta["Server"].(*instanceSDK.Server)
+				image := ctx.Result.(*instanceSDK.GetImageResponse).Image
+				assert.Equal(t, server.Name+"-backup", image.Name)
+			},
+		),
+		AfterFunc: core.AfterFuncCombine(
+			core.ExecAfterCmd("scw instance image delete {{ .CmdResult.Image.ID }} with-snapshots=true"),
+			core.ExecAfterCmd("scw instance server delete {{ .Server.ID }} with-ip=true with-volumes=local"),
+		),
+	}))
 }
 
 func Test_ServerAction(t *testing.T) {
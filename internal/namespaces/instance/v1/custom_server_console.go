@@ -23,7 +23,10 @@ type instanceConsoleServerArgs struct {
 
 func serverConsoleCommand() *core.Command {
 	return &core.Command{
-		Short:     `Connect to the serial console of an instance`,
+		Short: `Connect to the serial console of an instance`,
+		Long: `Connect the terminal to the serial console of an instance, in raw mode so that every key is sent to the instance.
+The serial console stays available when the network of the instance is down, it helps debugging boot issues when SSH does not answer.
+Type Ctrl+q to detach from the console.`,
 		Namespace: "instance",
 		Verb:      "console",
 		Resource:  "server",
@@ -37,6 +40,12 @@ func serverConsoleCommand() *core.Command {
 			},
 			core.ZoneArgSpec((*instance.API)(nil).Zones()...),
 		},
+		Examples: []*core.Example{
+			{
+				Short: "Connect to the serial console of an instance",
+				Raw:   "scw instance server console 11111111-1111-1111-1111-111111111111",
+			},
+		},
 		Run: instanceServerConsoleRun,
 	}
 }
@@ -68,7 +77,7 @@ func instanceServerConsoleRun(ctx context.Context, argsI interface{}) (i interfa
 	// Add hint on how to quit properly
 	fmt.Printf(terminal.Style("Open connection to %s (%s)\n", color.Bold), server.Name, server.ID)
 	fmt.Println(" - You may need to hit enter to start")
-	fmt.Println(" - Type Ctrl+q to quit.")
+	fmt.Println(" - Type Ctrl+q to detach.")
 	fmt.Println(interactive.Line("-"))
 
 	if err = ttyClient.Connect(); err != nil {
@@ -7,3 +7,7 @@ import "github.com/scaleway/scaleway-cli/v2/internal/core"
 func serverConsoleCommand() *core.Command {
 	return nil
 }
+
+func serverLogsCommand() *core.Command {
+	return nil
+}
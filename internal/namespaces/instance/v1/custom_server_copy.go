@@ -0,0 +1,160 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"reflect"
+	"strings"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/scaleway-sdk-go/validation"
+)
+
+type instanceCopyServerRequest struct {
+	Zone      scw.Zone
+	Paths     []string
+	Username  string
+	Port      uint
+	Recursive bool
+}
+
+func serverCopyCommand() *core.Command {
+	return &core.Command{
+		Short: `Copy files to or from a server`,
+		Long: `Copy files between the local host and servers with scp.
+The last path is the destination, the other ones are the sources. A path on a server is written <server-id>:<path>.
+Servers are reached on their public IP like with scw instance server ssh, scp displays the progress of each file when run in a terminal.`,
+		Namespace: "instance",
+		Verb:      "copy",
+		Resource:  "server",
+		ArgsType:  reflect.TypeOf(instanceCopyServerRequest{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:       "paths",
+				Short:      "Source paths followed by the destination path, a path on a server is written <server-id>:<path>",
+				Required:   false,
+				Positional: true,
+			},
+			{
+				Name:  "username",
+				Short: "Username used for the SSH connection, root by default or Administrator on Windows servers",
+			},
+			{
+				Name:    "port",
+				Short:   "Port used for the SSH connection",
+				Default: core.DefaultValueSetter("22"),
+			},
+			{
+				Name:  "recursive",
+				Short: "Copy directories recursively",
+			},
+			core.ZoneArgSpec((*instance.API)(nil).Zones()...),
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Upload a file to a server",
+				Raw:   "scw instance server copy ./app.tar.gz 11111111-1111-1111-1111-111111111111:/tmp/",
+			},
+			{
+				Short: "Download a directory from a server",
+				Raw:   "scw instance server copy 11111111-1111-1111-1111-111111111111:/var/log/nginx ./logs recursive=true",
+			},
+		},
+		SeeAlsos: []*core.SeeAlso{
+			{
+				Short:   "SSH into a server",
+				Command: "scw instance server ssh",
+			},
+		},
+		AcceptMultiplePositionalArgs: true,
+		Run:                          instanceServerCopyRun,
+	}
+}
+
+func instanceServerCopyRun(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+	args := argsI.(*instanceCopyServerRequest)
+
+	if len(args.Paths) < 2 {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("a source and a destination path are required"),
+			Hint: fmt.Sprintf("Copy a file to a server with: %s instance server copy <path> <server-id>:<path>", core.ExtractBinaryName(ctx)),
+		}
+	}
+
+	client := core.ExtractClient(ctx)
+	apiInstance := instance.NewAPI(client)
+
+	servers := map[string]*instance.Server{}
+	scpPaths := make([]string, 0, len(args.Paths))
+	for _, path := range args.Paths {
+		serverID, serverPath, isRemote := splitServerPath(path)
+		if !isRemote {
+			scpPaths = append(scpPaths, path)
+			continue
+		}
+
+		server, exists := servers[serverID]
+		if !exists {
+			var err error
+			server, err = getReachableServer(ctx, apiInstance, args.Zone, serverID)
+			if err != nil {
+				return nil, err
+			}
+			servers[serverID] = server
+		}
+
+		username := args.Username
+		if username == "" {
+			username = serverSSHUsername(server)
+		}
+		scpPaths = append(scpPaths, fmt.Sprintf("%s@%s:%s", username, scpHost(serverPublicAddress(server)), serverPath))
+	}
+
+	if len(servers) == 0 {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("none of the paths is on a server"),
+			Hint: "Prefix a path with the ID of a server, e.g. 11111111-1111-1111-1111-111111111111:/tmp/",
+		}
+	}
+
+	scpArgs := []string{
+		"-P", fmt.Sprintf("%d", args.Port),
+	}
+	if args.Recursive {
+		scpArgs = append(scpArgs, "-r")
+	}
+	scpArgs = append(scpArgs, scpPaths...)
+
+	exitCode, err := core.ExecCmd(ctx, exec.Command("scp", scpArgs...))
+	if err != nil {
+		return nil, err
+	}
+	if exitCode != 0 {
+		return nil, &core.CliError{Empty: true, Code: exitCode}
+	}
+
+	return &core.SuccessResult{Empty: true}, nil
+}
+
+// splitServerPath splits a path written <server-id>:<path>.
+// Paths not starting with a server ID are local, which keeps paths such as C:\file local.
+func splitServerPath(path string) (serverID string, serverPath string, isRemote bool) {
+	serverID, serverPath, found := strings.Cut(path, ":")
+	if !found || !validation.IsUUID(serverID) {
+		return "", "", false
+	}
+	return serverID, serverPath, true
+}
+
+// scpHost returns an address as a host of a scp path, IPv6 addresses have to be enclosed in brackets
+func scpHost(address string) string {
+	ip := net.ParseIP(address)
+	if ip != nil && ip.To4() == nil {
+		return "[" + address + "]"
+	}
+	return address
+}
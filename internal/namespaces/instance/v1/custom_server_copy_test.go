@@ -0,0 +1,69 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/instance/v1"
+)
+
+func Test_ServerCopy(t *testing.T) {
+	t.Run("Upload", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			createServerBionic("Server"),
+			startServer("Server"),
+		),
+		Cmd: "scw instance server copy ./app.tar.gz {{ .Server.ID }}:/tmp/",
+		OverrideExec: core.OverrideExecSimple(
+			"scp -P 22 ./app.tar.gz root@{{ .Server.PublicIP.Address }}:/tmp/",
+			0,
+		),
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+
+	t.Run("Download recursive", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			createServerBionic("Server"),
+			startServer("Server"),
+		),
+		Cmd: "scw instance server copy {{ .Server.ID }}:/var/log/nginx ./logs recursive=true",
+		OverrideExec: core.OverrideExecSimple(
+			"scp -P 22 -r root@{{ .Server.PublicIP.Address }}:/var/log/nginx ./logs",
+			0,
+		),
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+
+	t.Run("Without server path", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		Cmd:      "scw instance server copy ./app.tar.gz /tmp/",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+	}))
+
+	t.Run("Stopped server", core.Test(&core.TestConfig{
+		Commands:   instance.GetCommands(),
+		BeforeFunc: createServerBionic("Server"),
+		Cmd:        "scw instance server copy ./app.tar.gz {{ .Server.ID }}:/tmp/",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+}
@@ -3,9 +3,11 @@ package instance
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -30,6 +32,7 @@ type instanceCreateServerRequest struct {
 	Tags              []string
 	IPv6              bool
 	Stopped           bool
+	WaitSSH           bool
 	SecurityGroupID   string
 	PlacementGroupID  string
 
@@ -58,11 +61,12 @@ func serverCreateCommand() *core.Command {
 		ArgsType:  reflect.TypeOf(instanceCreateServerRequest{}),
 		ArgSpecs: core.ArgSpecs{
 			{
-				Name:             "image",
-				Short:            "Image ID or label of the server",
-				Default:          core.DefaultValueSetter("ubuntu_jammy"),
-				Required:         true,
-				AutoCompleteFunc: instanceServerCreateImageAutoCompleteFunc,
+				Name:                 "image",
+				Short:                "Image ID or label of the server",
+				Default:              core.DefaultValueSetter("ubuntu_jammy"),
+				Required:             true,
+				EnumValuesFunc:       listImageLabels,
+				EnumValuesPrefixFunc: normalizeImageLabel,
 			},
 			{
 				Name:     "type",
@@ -73,7 +77,7 @@ func serverCreateCommand() *core.Command {
 					// Allow all commercial types
 					return nil
 				},
-				AutoCompleteFunc: completeServerType,
+				EnumValuesFunc: listServerTypes,
 			},
 			{
 				Name:    "name",
@@ -105,6 +109,10 @@ func serverCreateCommand() *core.Command {
 				Name:  "stopped",
 				Short: "Do not start server after its creation",
 			},
+			{
+				Name:  "wait-ssh",
+				Short: "Wait for the server to accept SSH connections on port 22 after its start",
+			},
 			{
 				Name:  "security-group-id",
 				Short: "The security group ID used for this server",
@@ -119,7 +127,7 @@ func serverCreateCommand() *core.Command {
 			},
 			{
 				Name:        "cloud-init",
-				Short:       "The cloud-init script to use",
+				Short:       "The cloud-init script to use, it is set before the first boot of the server",
 				CanLoadFile: true,
 			},
 			{
@@ -173,6 +181,10 @@ func serverCreateCommand() *core.Command {
 				Raw: `ip=$(scw instance ip create | grep id | awk '{ print $2 }')
 scw instance server create image=ubuntu_focal ip=$ip`,
 			},
+			{
+				Short: "Create an instance configured by a cloud-init file",
+				Raw:   "scw instance server create image=ubuntu_focal cloud-init=@./init.yaml",
+			},
 		},
 	}
 }
@@ -195,6 +207,13 @@ func instanceServerCreateRun(ctx context.Context, argsI interface{}) (i interfac
 	// STEP 1: Argument validation and API requests creation.
 	//
 
+	if args.Stopped && args.WaitSSH {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("cannot wait for SSH on a server that is not started"),
+			Hint: "Remove stopped=true or wait-ssh=true",
+		}
+	}
+
 	needIPCreation := false
 
 	serverReq := &instance.CreateServerRequest{
@@ -242,6 +261,10 @@ func instanceServerCreateRun(ctx context.Context, argsI interface{}) (i interfac
 			CommercialType: serverReq.CommercialType,
 			Type:           marketplace.LocalImageTypeInstanceLocal,
 		})
+		notFoundError := (*scw.ResourceNotFoundError)(nil)
+		if errors.As(err, &notFoundError) {
+			return nil, imageLabelNotFoundError(ctx, imageLabel, err)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -435,10 +458,13 @@ func instanceServerCreateRun(ctx context.Context, argsI interface{}) (i interfac
 			Content:  bytes.NewBufferString(args.CloudInit),
 		})
 		if err != nil {
-			logger.Warningf("error while setting up your cloud-init metadata: %s. Note that the server is successfully created.", err)
-		} else {
-			logger.Debugf("cloud-init set")
+			// The server is left stopped so that it does not boot without its configuration
+			return nil, &core.CliError{
+				Err:  fmt.Errorf("cannot set the cloud-init of server %s: %s", server.ID, err),
+				Hint: fmt.Sprintf("The server is created but not started, set its cloud-init with: %s instance user-data set server-id=%s zone=%s key=cloud-init content=@<file> and start it", core.ExtractBinaryName(ctx), server.ID, server.Zone),
+			}
 		}
+		logger.Debugf("cloud-init set")
 	}
 
 	//
@@ -458,6 +484,10 @@ func instanceServerCreateRun(ctx context.Context, argsI interface{}) (i interfac
 		}
 	}
 
+	if args.WaitSSH {
+		return waitForServerSSH(ctx, args.Zone, server.ID, sshDefaultPort, serverActionTimeout)
+	}
+
 	return server, nil
 }
 
@@ -743,32 +773,24 @@ func sanitizeVolumeMap(serverName string, volumes map[string]*instance.VolumeSer
 	return m
 }
 
-// Caching listImage response for shell completion
-var completeListImagesCache *marketplace.ListImagesResponse
-
-func instanceServerCreateImageAutoCompleteFunc(ctx context.Context, prefix string, _ any) core.AutocompleteSuggestions {
-	suggestions := core.AutocompleteSuggestions(nil)
-
-	client := core.ExtractClient(ctx)
-	api := marketplace.NewAPI(client)
-
-	if completeListImagesCache == nil {
-		res, err := api.ListImages(&marketplace.ListImagesRequest{}, scw.WithAllPages())
-		if err != nil {
-			return nil
-		}
-		completeListImagesCache = res
+// listImageLabels lists the labels of the marketplace images.
+func listImageLabels(ctx context.Context, _ any) ([]string, error) {
+	res, err := marketplace.NewAPI(core.ExtractClient(ctx)).ListImages(&marketplace.ListImagesRequest{}, scw.WithAllPages())
+	if err != nil {
+		return nil, err
 	}
 
-	prefix = strings.ToLower(strings.Replace(prefix, "-", "_", -1))
-
-	for _, image := range completeListImagesCache.Images {
-		if strings.HasPrefix(image.Label, prefix) {
-			suggestions = append(suggestions, image.Label)
-		}
+	labels := make([]string, 0, len(res.Images))
+	for _, image := range res.Images {
+		labels = append(labels, image.Label)
 	}
 
-	return suggestions
+	return labels, nil
+}
+
+// normalizeImageLabel allows to complete image labels typed like the names of the images, e.g. Ubuntu-Jammy.
+func normalizeImageLabel(label string) string {
+	return strings.ToLower(strings.ReplaceAll(label, "-", "_"))
 }
 
 // getServerType is a util to get a instance.ServerType by its commercialType
@@ -812,3 +834,32 @@ func instanceServerCreateIPCreate(args *instanceCreateServerRequest, api *instan
 
 	return res.IP, nil
 }
+
+// imageLabelNotFoundError returns the error of an unknown image label.
+// The labels containing it are given as candidates, e.g. ubuntu_focal and ubuntu_jammy for ubuntu.
+func imageLabelNotFoundError(ctx context.Context, imageLabel string, err error) error {
+	labels, listErr := listImageLabels(ctx, nil)
+	if listErr != nil {
+		return err
+	}
+
+	candidates := []string(nil)
+	for _, label := range labels {
+		if strings.Contains(label, strings.ToLower(imageLabel)) {
+			candidates = append(candidates, label)
+		}
+	}
+	sort.Strings(candidates)
+
+	if len(candidates) == 0 {
+		return &core.CliError{
+			Err:  fmt.Errorf("unknown image label '%s'", imageLabel),
+			Hint: "Use 'scw marketplace image list' to list the available image labels.",
+		}
+	}
+	return &core.CliError{
+		Err:     fmt.Errorf("image label '%s' is ambiguous", imageLabel),
+		Details: fmt.Sprintf("Candidates are:\n  - %s", strings.Join(candidates, "\n  - ")),
+		Hint:    "Use one of the candidates as image label.",
+	}
+}
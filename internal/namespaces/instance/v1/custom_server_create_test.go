@@ -1,7 +1,10 @@
 package instance_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
@@ -11,6 +14,7 @@ import (
 	"github.com/alecthomas/assert"
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/require"
 )
 
 // deleteServerAfterFunc deletes the created server and its attached volumes and IPs.
@@ -304,6 +308,16 @@ func Test_CreateServerErrors(t *testing.T) {
 		DisableParallel: true,
 	}))
 
+	t.Run("Error: ambiguous image label", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		Cmd:      "scw instance server create image=ubuntu",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+		DisableParallel: true,
+	}))
+
 	t.Run("Error: invalid image UUID", core.Test(&core.TestConfig{
 		Commands: instance.GetCommands(),
 		Cmd:      "scw instance server create image=7a892c1a-bbdc-491f-9974-4008e3708664",
@@ -545,3 +559,29 @@ func Test_CreateServerScratchStorage(t *testing.T) {
 		DisableParallel: true,
 	}))
 }
+
+func Test_CreateServerImageAutoComplete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"images": [{"label": "ubuntu_focal"}, {"label": "ubuntu_jammy"}], "total_count": 2}`))
+	}))
+	defer server.Close()
+
+	client, err := scw.NewClient(
+		scw.WithAPIURL(server.URL),
+		scw.WithAuth("SCWXXXXXXXXXXXXXXXXX", "11111111-1111-1111-1111-111111111111"),
+		scw.WithDefaultZone(scw.ZoneFrPar1),
+	)
+	require.NoError(t, err)
+
+	ctx := core.InjectMeta(context.Background(), &core.Meta{
+		Commands: instance.GetCommands(),
+		Client:   client,
+		OverrideEnv: map[string]string{
+			scw.ScwCacheDirEnv: t.TempDir(),
+		},
+	})
+
+	result := core.AutoComplete(ctx, []string{"scw", "instance", "server", "create"}, "image=Ubuntu-J", nil)
+	assert.Equal(t, core.AutocompleteSuggestions{"image=ubuntu_jammy"}, result.Suggestions)
+}
@@ -0,0 +1,194 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"reflect"
+	"slices"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+type instanceExecServerRequest struct {
+	Zone      scw.Zone
+	ServerIDs []string
+	Tags      []string
+	Name      string
+	Username  string
+	Port      uint
+}
+
+func serverExecCommand() *core.Command {
+	return &core.Command{
+		Short: `Run a command on servers over SSH`,
+		Long: `Run a one-off command on one or several servers over SSH, the command is given after --.
+Servers are given by their IDs or selected by their tags or name, as with scw instance server list.
+The exit code of the command is the exit code of scw, when run on several servers it is the highest exit code.
+Every server is checked to be running and reachable before running the command on any of them.`,
+		Namespace: "instance",
+		Verb:      "exec",
+		Resource:  "server",
+		ArgsType:  reflect.TypeOf(instanceExecServerRequest{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:       "server-ids",
+				Short:      "IDs of the servers to run the command on",
+				Required:   false,
+				Positional: true,
+			},
+			{
+				Name:  "tags.{index}",
+				Short: "Run the command on every server having all these tags",
+			},
+			{
+				Name:  "name",
+				Short: "Run the command on every server whose name contains this string",
+			},
+			{
+				Name:  "username",
+				Short: "Username used for the SSH connection, root by default or Administrator on Windows servers",
+			},
+			{
+				Name:    "port",
+				Short:   "Port used for the SSH connection",
+				Default: core.DefaultValueSetter("22"),
+			},
+			core.ZoneArgSpec((*instance.API)(nil).Zones()...),
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Run a command on a server",
+				Raw:   "scw instance server exec 11111111-1111-1111-1111-111111111111 -- uptime",
+			},
+			{
+				Short: "Run a command on every server tagged web",
+				Raw:   "scw instance server exec tags.0=web -- systemctl restart nginx",
+			},
+		},
+		SeeAlsos: []*core.SeeAlso{
+			{
+				Short:   "SSH into a server",
+				Command: "scw instance server ssh",
+			},
+		},
+		AcceptMultiplePositionalArgs: true,
+		AcceptNoPositionalArgs:       true,
+		AcceptPassthroughArgs:        true,
+		Run:                          instanceServerExecRun,
+	}
+}
+
+func instanceServerExecRun(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+	args := argsI.(*instanceExecServerRequest)
+
+	remoteCommand := core.ExtractPassthroughArgs(ctx)
+	if len(remoteCommand) == 0 {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("no command to run"),
+			Hint: fmt.Sprintf("Give the command to run after --, e.g. %s instance server exec <server-id> -- uptime", core.ExtractBinaryName(ctx)),
+		}
+	}
+
+	client := core.ExtractClient(ctx)
+	apiInstance := instance.NewAPI(client)
+
+	serverIDs := args.ServerIDs
+	if len(args.Tags) > 0 || args.Name != "" {
+		listRequest := &instance.ListServersRequest{
+			Zone: args.Zone,
+			Tags: args.Tags,
+		}
+		if args.Name != "" {
+			listRequest.Name = scw.StringPtr(args.Name)
+		}
+		listResp, err := apiInstance.ListServers(listRequest, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, server := range listResp.Servers {
+			if !slices.Contains(serverIDs, server.ID) {
+				serverIDs = append(serverIDs, server.ID)
+			}
+		}
+	}
+	if len(serverIDs) == 0 {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("no server to run the command on"),
+			Hint: "Give the IDs of the servers or select them with tags.0=<tag> or name=<name>",
+		}
+	}
+
+	// Servers are all checked before running anything so that the command is run on all of them or none
+	servers := make([]*instance.Server, 0, len(serverIDs))
+	for _, serverID := range serverIDs {
+		server, err := getReachableServer(ctx, apiInstance, args.Zone, serverID)
+		if err != nil {
+			return nil, err
+		}
+		servers = append(servers, server)
+	}
+
+	highestExitCode := 0
+	for _, server := range servers {
+		if len(servers) > 1 {
+			_, _ = interactive.Printf("==> %s (%s)\n", server.Name, server.ID)
+		}
+
+		username := args.Username
+		if username == "" {
+			username = serverSSHUsername(server)
+		}
+
+		sshArgs := []string{
+			serverPublicAddress(server),
+			"-p", fmt.Sprintf("%d", args.Port),
+			"-l", username,
+			"--",
+		}
+		sshArgs = append(sshArgs, remoteCommand...)
+
+		exitCode, err := core.ExecCmd(ctx, exec.Command("ssh", sshArgs...))
+		if err != nil {
+			return nil, err
+		}
+		if exitCode > highestExitCode {
+			highestExitCode = exitCode
+		}
+	}
+
+	if highestExitCode != 0 {
+		return nil, &core.CliError{Empty: true, Code: highestExitCode}
+	}
+
+	return &core.SuccessResult{Empty: true}, nil
+}
+
+// getReachableServer returns a server that is running and has a public IP to connect to over SSH
+func getReachableServer(ctx context.Context, api *instance.API, zone scw.Zone, serverID string) (*instance.Server, error) {
+	serverResp, err := api.GetServer(&instance.GetServerRequest{
+		Zone:     zone,
+		ServerID: serverID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	server := serverResp.Server
+
+	if server.State != instance.ServerStateRunning {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("server %s is not running", server.ID),
+			Hint: fmt.Sprintf("Start the instance with: %s instance server start %s --wait", core.ExtractBinaryName(ctx), server.ID),
+		}
+	}
+	if serverPublicAddress(server) == "" {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("server %s does not have a public IP to connect to", server.ID),
+			Hint: fmt.Sprintf("Add a public IP to the instance with: %s instance server update %s ip=<ip_id>", core.ExtractBinaryName(ctx), server.ID),
+		}
+	}
+	return server, nil
+}
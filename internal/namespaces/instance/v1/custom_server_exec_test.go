@@ -0,0 +1,81 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/instance/v1"
+)
+
+func Test_ServerExec(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			createServerBionic("Server"),
+			startServer("Server"),
+		),
+		Cmd: "scw instance server exec {{ .Server.ID }} -- uptime -p",
+		OverrideExec: core.OverrideExecSimple(
+			"ssh {{ .Server.PublicIP.Address }} -p 22 -l root -- uptime -p",
+			0,
+		),
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+
+	t.Run("With-Exit-Code", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			createServerBionic("Server"),
+			startServer("Server"),
+		),
+		Cmd: "scw instance server exec {{ .Server.ID }} -- false",
+		OverrideExec: core.OverrideExecSimple(
+			"ssh {{ .Server.PublicIP.Address }} -p 22 -l root -- false",
+			1,
+		),
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+
+	t.Run("Without command", core.Test(&core.TestConfig{
+		Commands:   instance.GetCommands(),
+		BeforeFunc: createServerBionic("Server"),
+		Cmd:        "scw instance server exec {{ .Server.ID }}",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+
+	t.Run("Stopped server", core.Test(&core.TestConfig{
+		Commands:   instance.GetCommands(),
+		BeforeFunc: createServerBionic("Server"),
+		Cmd:        "scw instance server exec {{ .Server.ID }} -- uptime",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+
+	t.Run("Without server", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		Cmd:      "scw instance server exec -- uptime",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+	}))
+}
@@ -0,0 +1,108 @@
+//go:build !wasm
+
+package instance
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/gotty"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// serverLogsIdleTimeout is how long the console is read without receiving output before considering the recent output was printed
+const serverLogsIdleTimeout = 3 * time.Second
+
+type instanceLogsServerArgs struct {
+	Zone     scw.Zone
+	ServerID string
+	Follow   bool
+}
+
+func serverLogsCommand() *core.Command {
+	return &core.Command{
+		Short: `Print the serial console output of an instance`,
+		Long: `Print the recent output of the serial console of an instance, such as its kernel and boot logs, without attaching to the console.
+The command exits once no output was received for a few seconds, or keeps printing new output until interrupted with follow=true.`,
+		Namespace: "instance",
+		Verb:      "logs",
+		Resource:  "server",
+		ArgsType:  reflect.TypeOf(instanceLogsServerArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:       "server-id",
+				Short:      "Server ID to print the console output of",
+				Required:   true,
+				Positional: true,
+			},
+			{
+				Name:  "follow",
+				Short: "Keep printing new output until interrupted",
+			},
+			core.ZoneArgSpec((*instance.API)(nil).Zones()...),
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Print the boot logs of an instance",
+				Raw:   "scw instance server logs 11111111-1111-1111-1111-111111111111",
+			},
+			{
+				Short: "Follow the console output of an instance",
+				Raw:   "scw instance server logs 11111111-1111-1111-1111-111111111111 follow=true",
+			},
+		},
+		SeeAlsos: []*core.SeeAlso{
+			{
+				Short:   "Connect to the serial console of an instance",
+				Command: "scw instance server console",
+			},
+		},
+		Run: instanceServerLogsRun,
+	}
+}
+
+func instanceServerLogsRun(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+	args := argsI.(*instanceLogsServerArgs)
+
+	client := core.ExtractClient(ctx)
+	apiInstance := instance.NewAPI(client)
+	serverResp, err := apiInstance.GetServer(&instance.GetServerRequest{
+		Zone:     args.Zone,
+		ServerID: args.ServerID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	server := serverResp.Server
+
+	secretKey, ok := client.GetSecretKey()
+	if !ok {
+		return nil, fmt.Errorf("could not get secret key")
+	}
+
+	ttyClient, err := gotty.NewClient(server.Zone, server.ID, secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Output is streamed as it comes when following, it is printed at once otherwise
+	if args.Follow {
+		err = ttyClient.ReadOutput(ctx, core.ExtractStdout(ctx), 0)
+		if err != nil {
+			return nil, err
+		}
+		return &core.SuccessResult{Empty: true}, nil
+	}
+
+	output := &bytes.Buffer{}
+	err = ttyClient.ReadOutput(ctx, output, serverLogsIdleTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return core.RawResult(output.Bytes()), nil
+}
@@ -21,8 +21,10 @@ type instanceSSHServerRequest struct {
 
 func serverSSHCommand() *core.Command {
 	return &core.Command{
-		Short:     `SSH into a server`,
-		Long:      `Connect to distant server via the SSH protocol.`,
+		Short: `SSH into a server`,
+		Long: `Connect to distant server via the SSH protocol.
+The server is reached on its public IPv4, or on its public IPv6 when it has no IPv4.
+Arguments given after -- are passed to ssh, e.g. to forward a port.`,
 		Namespace: "instance",
 		Verb:      "ssh",
 		Resource:  "server",
@@ -35,9 +37,8 @@ func serverSSHCommand() *core.Command {
 				Positional: true,
 			},
 			{
-				Name:    "username",
-				Short:   "Username used for the SSH connection",
-				Default: core.DefaultValueSetter("root"),
+				Name:  "username",
+				Short: "Username used for the SSH connection, root by default or Administrator on Windows servers",
 			},
 			{
 				Name:    "port",
@@ -50,7 +51,18 @@ func serverSSHCommand() *core.Command {
 			},
 			core.ZoneArgSpec((*instance.API)(nil).Zones()...),
 		},
-		Run: instanceServerSSHRun,
+		Examples: []*core.Example{
+			{
+				Short: "SSH into a server",
+				Raw:   "scw instance server ssh 11111111-1111-1111-1111-111111111111",
+			},
+			{
+				Short: "SSH into a server, forwarding the local port 8080 to the port 80 of the server",
+				Raw:   "scw instance server ssh 11111111-1111-1111-1111-111111111111 -- -L 8080:localhost:80",
+			},
+		},
+		AcceptPassthroughArgs: true,
+		Run:                   instanceServerSSHRun,
 	}
 }
 
@@ -74,19 +86,26 @@ func instanceServerSSHRun(ctx context.Context, argsI interface{}) (i interface{}
 		}
 	}
 
-	if serverResp.Server.PublicIP == nil {
+	address := serverPublicAddress(serverResp.Server)
+	if address == "" {
 		return nil, &core.CliError{
 			Err:  fmt.Errorf("server does not have a public IP to connect to"),
 			Hint: fmt.Sprintf("Add a public IP to the instance with: %s instance server update %s ip=<ip_id>", core.ExtractBinaryName(ctx), serverResp.Server.ID),
 		}
 	}
 
+	username := args.Username
+	if username == "" {
+		username = serverSSHUsername(serverResp.Server)
+	}
+
 	sshArgs := []string{
-		serverResp.Server.PublicIP.Address.String(),
+		address,
 		"-p", fmt.Sprintf("%d", args.Port),
-		"-l", args.Username,
+		"-l", username,
 		"-t",
 	}
+	sshArgs = append(sshArgs, core.ExtractPassthroughArgs(ctx)...)
 	if args.Command != "" {
 		sshArgs = append(sshArgs, args.Command)
 	}
@@ -103,3 +122,30 @@ func instanceServerSSHRun(ctx context.Context, argsI interface{}) (i interface{}
 
 	return &core.SuccessResult{Empty: true}, nil
 }
+
+// serverPublicAddress returns the address to reach a server on, its public IPv4 first or else its public IPv6.
+// It returns an empty string when the server has no public IP.
+func serverPublicAddress(server *instance.Server) string {
+	if server.PublicIP != nil {
+		return server.PublicIP.Address.String()
+	}
+	for _, family := range []instance.ServerIPIPFamily{instance.ServerIPIPFamilyInet, instance.ServerIPIPFamilyInet6} {
+		for _, ip := range server.PublicIPs {
+			if ip.Family == family {
+				return ip.Address.String()
+			}
+		}
+	}
+	if server.IPv6 != nil {
+		return server.IPv6.Address.String()
+	}
+	return ""
+}
+
+// serverSSHUsername returns the default user of the image of a server
+func serverSSHUsername(server *instance.Server) string {
+	if commercialTypeIsWindowsServer(server.CommercialType) {
+		return "Administrator"
+	}
+	return "root"
+}
@@ -47,6 +47,25 @@ func Test_ServerSSH(t *testing.T) {
 		DisableParallel: true,
 	}))
 
+	t.Run("With-Passthrough-Args", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			createServerBionic("Server"),
+			startServer("Server"),
+		),
+		Cmd: "scw instance server ssh {{ .Server.ID }} -- -A -L 8080:localhost:80",
+		OverrideExec: core.OverrideExecSimple(
+			"ssh {{ .Server.PublicIP.Address }} -p 22 -l root -t -A -L 8080:localhost:80",
+			0,
+		),
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+
 	t.Run("Stopped server", core.Test(&core.TestConfig{
 		Commands:   instance.GetCommands(),
 		BeforeFunc: createServerBionic("Server"),
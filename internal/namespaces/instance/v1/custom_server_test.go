@@ -180,6 +180,21 @@ func Test_ServerUpdateCustom(t *testing.T) {
 		),
 	}))
 
+	t.Run("Update server placement-group-id on running server", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			createServerBionic("Server"),
+			startServer("Server"),
+		),
+		// The server is rejected before the placement group is looked up
+		Cmd: "scw instance server update {{ .Server.ID }} placement-group-id=11111111-1111-1111-1111-111111111111",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+		AfterFunc: deleteServer("Server"),
+	}))
+
 	// Security group cases.
 	t.Run("Update server security-group-id from server with security-group-id", core.Test(&core.TestConfig{
 		Commands: instance.GetCommands(),
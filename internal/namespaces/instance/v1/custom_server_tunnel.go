@@ -0,0 +1,198 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"reflect"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+type instanceTunnelServerRequest struct {
+	Zone       scw.Zone
+	ServerID   string
+	LocalPort  uint
+	RemotePort uint
+	RemoteHost string
+	GatewayID  string
+	Username   string
+	Port       uint
+}
+
+func serverTunnelCommand() *core.Command {
+	return &core.Command{
+		Short: `Forward a local port through a server`,
+		Long: `Open an SSH tunnel forwarding a local port to a port reachable from a server, the tunnel stays open until interrupted.
+The remote host is resolved by the server, it can be the server itself or a host of its private networks such as a database.
+Servers without a public IP can be reached through the SSH bastion of a Public Gateway attached to one of their private networks.`,
+		Namespace: "instance",
+		Verb:      "tunnel",
+		Resource:  "server",
+		ArgsType:  reflect.TypeOf(instanceTunnelServerRequest{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:       "server-id",
+				Short:      "Server ID to open the tunnel through",
+				Required:   true,
+				Positional: true,
+			},
+			{
+				Name:     "local-port",
+				Short:    "Local port to listen on",
+				Required: true,
+			},
+			{
+				Name:     "remote-port",
+				Short:    "Port to forward to on the remote host",
+				Required: true,
+			},
+			{
+				Name:    "remote-host",
+				Short:   "Host to forward to, as resolved by the server",
+				Default: core.DefaultValueSetter("localhost"),
+			},
+			{
+				Name:  "gateway-id",
+				Short: "Public Gateway whose SSH bastion is used to reach the server on its private network",
+			},
+			{
+				Name:  "username",
+				Short: "Username used for the SSH connection, root by default or Administrator on Windows servers",
+			},
+			{
+				Name:    "port",
+				Short:   "Port used for the SSH connection",
+				Default: core.DefaultValueSetter("22"),
+			},
+			core.ZoneArgSpec((*instance.API)(nil).Zones()...),
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Reach a PostgreSQL running on a server on the local port 5432",
+				Raw:   "scw instance server tunnel 11111111-1111-1111-1111-111111111111 local-port=5432 remote-port=5432",
+			},
+			{
+				Short: "Reach a database of a private network through a server",
+				Raw:   "scw instance server tunnel 11111111-1111-1111-1111-111111111111 local-port=5432 remote-port=5432 remote-host=192.168.0.10",
+			},
+			{
+				Short: "Reach a server without a public IP through the bastion of a Public Gateway",
+				Raw:   "scw instance server tunnel 11111111-1111-1111-1111-111111111111 local-port=8080 remote-port=80 gateway-id=22222222-2222-2222-2222-222222222222",
+			},
+		},
+		SeeAlsos: []*core.SeeAlso{
+			{
+				Short:   "SSH into a server",
+				Command: "scw instance server ssh",
+			},
+		},
+		Run: instanceServerTunnelRun,
+	}
+}
+
+func instanceServerTunnelRun(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+	args := argsI.(*instanceTunnelServerRequest)
+
+	client := core.ExtractClient(ctx)
+	apiInstance := instance.NewAPI(client)
+
+	var server *instance.Server
+	sshArgs := []string{
+		"-N",
+		"-L", fmt.Sprintf("%d:%s:%d", args.LocalPort, args.RemoteHost, args.RemotePort),
+		"-p", fmt.Sprintf("%d", args.Port),
+	}
+	address := ""
+
+	if args.GatewayID == "" {
+		var err error
+		server, err = getReachableServer(ctx, apiInstance, args.Zone, args.ServerID)
+		if err != nil {
+			return nil, err
+		}
+		address = serverPublicAddress(server)
+	} else {
+		serverResp, err := apiInstance.GetServer(&instance.GetServerRequest{
+			Zone:     args.Zone,
+			ServerID: args.ServerID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		server = serverResp.Server
+		if server.State != instance.ServerStateRunning {
+			return nil, &core.CliError{
+				Err:  fmt.Errorf("server %s is not running", server.ID),
+				Hint: fmt.Sprintf("Start the instance with: %s instance server start %s --wait", core.ExtractBinaryName(ctx), server.ID),
+			}
+		}
+
+		bastion, privateAddress, err := serverBastion(ctx, args.Zone, args.GatewayID, server)
+		if err != nil {
+			return nil, err
+		}
+		sshArgs = append(sshArgs, "-J", bastion)
+		address = privateAddress
+	}
+
+	username := args.Username
+	if username == "" {
+		username = serverSSHUsername(server)
+	}
+	sshArgs = append(sshArgs, "-l", username, address)
+
+	_, _ = interactive.Printf("Forwarding localhost:%d to %s:%d through %s, press Ctrl+C to close the tunnel\n", args.LocalPort, args.RemoteHost, args.RemotePort, server.Name)
+
+	exitCode, err := core.ExecCmd(ctx, exec.Command("ssh", sshArgs...))
+	if err != nil {
+		return nil, err
+	}
+	if exitCode != 0 {
+		return nil, &core.CliError{Empty: true, Code: exitCode}
+	}
+
+	return &core.SuccessResult{Empty: true}, nil
+}
+
+// serverBastion returns the bastion of a gateway to jump through and the address of the server on the private network shared with the gateway.
+// The address is the hostname of the server in the DNS zone of the DHCP of the gateway network.
+func serverBastion(ctx context.Context, zone scw.Zone, gatewayID string, server *instance.Server) (bastion string, address string, err error) {
+	gwAPI := vpcgw.NewAPI(core.ExtractClient(ctx))
+	gateway, err := gwAPI.GetGateway(&vpcgw.GetGatewayRequest{
+		Zone:      zone,
+		GatewayID: gatewayID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", "", err
+	}
+
+	if !gateway.BastionEnabled || gateway.IP == nil {
+		return "", "", &core.CliError{
+			Err:  fmt.Errorf("SSH bastion of gateway %s is not enabled", gateway.ID),
+			Hint: fmt.Sprintf("Enable it with: %s vpc-gw gateway update %s enable-bastion=true", core.ExtractBinaryName(ctx), gateway.ID),
+		}
+	}
+
+	for _, network := range gateway.GatewayNetworks {
+		if network.DHCP == nil {
+			continue
+		}
+		for _, nic := range server.PrivateNics {
+			if nic.PrivateNetworkID == network.PrivateNetworkID {
+				bastion = fmt.Sprintf("bastion@%s:%d", gateway.IP.Address.String(), gateway.BastionPort)
+				address = fmt.Sprintf("%s.%s", server.Hostname, network.DHCP.DNSLocalName)
+				return bastion, address, nil
+			}
+		}
+	}
+
+	return "", "", &core.CliError{
+		Err:  fmt.Errorf("server %s is not on a private network of gateway %s", server.ID, gateway.ID),
+		Hint: "Attach the server to a private network of the gateway with DHCP enabled",
+	}
+}
@@ -0,0 +1,60 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/instance/v1"
+)
+
+func Test_ServerTunnel(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			createServerBionic("Server"),
+			startServer("Server"),
+		),
+		Cmd: "scw instance server tunnel {{ .Server.ID }} local-port=5432 remote-port=5432",
+		OverrideExec: core.OverrideExecSimple(
+			"ssh -N -L 5432:localhost:5432 -p 22 -l root {{ .Server.PublicIP.Address }}",
+			0,
+		),
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+
+	t.Run("Interrupted", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		BeforeFunc: core.BeforeFuncCombine(
+			createServerBionic("Server"),
+			startServer("Server"),
+		),
+		Cmd: "scw instance server tunnel {{ .Server.ID }} local-port=8080 remote-port=80 remote-host=192.168.0.10",
+		OverrideExec: core.OverrideExecSimple(
+			"ssh -N -L 8080:192.168.0.10:80 -p 22 -l root {{ .Server.PublicIP.Address }}",
+			130,
+		),
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(130),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+
+	t.Run("Stopped server", core.Test(&core.TestConfig{
+		Commands:   instance.GetCommands(),
+		BeforeFunc: createServerBionic("Server"),
+		Cmd:        "scw instance server tunnel {{ .Server.ID }} local-port=5432 remote-port=5432",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+}
@@ -32,6 +32,7 @@ var (
 // table of server types instead of a flat key/value list.
 // We need it for:
 // - [APIGW-1932] hide deprecated instance for scw instance server-type list
+// - listing the server types of all zones at once with zone=all
 func serverTypeListBuilder(c *core.Command) *core.Command {
 	deprecatedNames := map[string]struct{}{
 		"START1-L":    {},
@@ -58,58 +59,87 @@ func serverTypeListBuilder(c *core.Command) *core.Command {
 		"ARM64-128GB": {},
 	}
 
+	availableZones := ((*instance.API)(nil)).Zones()
+	availableZones = append(availableZones, scw.Zone(core.AllLocalities))
+	c.ArgSpecs.DeleteByName("zone")
+	c.ArgSpecs = append(c.ArgSpecs, core.ZoneArgSpec(availableZones...))
+
+	c.Examples = append(c.Examples, &core.Example{
+		Short: "List all server-types with their availability in every zone",
+		Raw:   "scw instance server-type list zone=all",
+	})
+
 	c.Run = func(ctx context.Context, argsI interface{}) (interface{}, error) {
 		type customServerType struct {
 			Name               string                           `json:"name"`
 			HourlyPrice        *scw.Money                       `json:"hourly_price"`
+			MonthlyPrice       *scw.Money                       `json:"monthly_price"`
 			LocalVolumeMaxSize scw.Size                         `json:"local_volume_max_size"`
 			CPU                uint32                           `json:"cpu"`
 			GPU                *uint64                          `json:"gpu"`
 			RAM                scw.Size                         `json:"ram"`
 			Arch               instance.Arch                    `json:"arch"`
 			Availability       instance.ServerTypesAvailability `json:"availability"`
+			Zone               scw.Zone                         `json:"zone"`
 		}
 
 		api := instance.NewAPI(core.ExtractClient(ctx))
 
-		// Get server types.
 		request := argsI.(*instance.ListServersTypesRequest)
-		listServersTypesResponse, err := api.ListServersTypes(request, scw.WithAllPages())
-		if err != nil {
-			return nil, err
+		zones := []scw.Zone{request.Zone}
+		if request.Zone == scw.Zone(core.AllLocalities) {
+			zones = api.Zones()
 		}
-		serverTypes := []*customServerType(nil)
 
-		// Get server availabilities.
-		availabilitiesResponse, err := api.GetServerTypesAvailability(&instance.GetServerTypesAvailabilityRequest{
-			Zone: request.Zone,
-		}, scw.WithAllPages())
-		if err != nil {
-			return nil, err
-		}
+		serverTypes := []*customServerType(nil)
 
-		for name, serverType := range listServersTypesResponse.Servers {
-			_, isDeprecated := deprecatedNames[name]
-			if isDeprecated {
-				continue
+		for _, zone := range zones {
+			// Get server types.
+			listServersTypesResponse, err := api.ListServersTypes(&instance.ListServersTypesRequest{
+				Zone: zone,
+			}, scw.WithAllPages(), scw.WithContext(ctx))
+			if err != nil {
+				return nil, err
 			}
 
-			serverTypeAvailability := instance.ServerTypesAvailability("unknown")
-
-			if availability, exists := availabilitiesResponse.Servers[name]; exists {
-				serverTypeAvailability = availability.Availability
+			// Get server availabilities.
+			availabilitiesResponse, err := api.GetServerTypesAvailability(&instance.GetServerTypesAvailabilityRequest{
+				Zone: zone,
+			}, scw.WithAllPages(), scw.WithContext(ctx))
+			if err != nil {
+				return nil, err
 			}
 
-			serverTypes = append(serverTypes, &customServerType{
-				Name:               name,
-				HourlyPrice:        scw.NewMoneyFromFloat(float64(serverType.HourlyPrice), "EUR", 3),
-				LocalVolumeMaxSize: serverType.VolumesConstraint.MaxSize,
-				CPU:                serverType.Ncpus,
-				GPU:                serverType.Gpu,
-				RAM:                scw.Size(serverType.RAM),
-				Arch:               serverType.Arch,
-				Availability:       serverTypeAvailability,
-			})
+			for name, serverType := range listServersTypesResponse.Servers {
+				_, isDeprecated := deprecatedNames[name]
+				if isDeprecated {
+					continue
+				}
+
+				serverTypeAvailability := instance.ServerTypesAvailability("unknown")
+
+				if availability, exists := availabilitiesResponse.Servers[name]; exists {
+					serverTypeAvailability = availability.Availability
+				}
+
+				monthlyPrice := (*scw.Money)(nil)
+				if serverType.MonthlyPrice != nil {
+					monthlyPrice = scw.NewMoneyFromFloat(float64(*serverType.MonthlyPrice), "EUR", 2)
+				}
+
+				serverTypes = append(serverTypes, &customServerType{
+					Name:               name,
+					HourlyPrice:        scw.NewMoneyFromFloat(float64(serverType.HourlyPrice), "EUR", 3),
+					MonthlyPrice:       monthlyPrice,
+					LocalVolumeMaxSize: serverType.VolumesConstraint.MaxSize,
+					CPU:                serverType.Ncpus,
+					GPU:                serverType.Gpu,
+					RAM:                scw.Size(serverType.RAM),
+					Arch:               serverType.Arch,
+					Availability:       serverTypeAvailability,
+					Zone:               zone,
+				})
+			}
 		}
 
 		sort.Slice(serverTypes, func(i, j int) bool {
@@ -118,7 +148,13 @@ func serverTypeListBuilder(c *core.Command) *core.Command {
 			if categoryA != categoryB {
 				return categoryA < categoryB
 			}
-			return serverTypes[i].HourlyPrice.ToFloat() < serverTypes[j].HourlyPrice.ToFloat()
+			if serverTypes[i].HourlyPrice.ToFloat() != serverTypes[j].HourlyPrice.ToFloat() {
+				return serverTypes[i].HourlyPrice.ToFloat() < serverTypes[j].HourlyPrice.ToFloat()
+			}
+			if serverTypes[i].Name != serverTypes[j].Name {
+				return serverTypes[i].Name < serverTypes[j].Name
+			}
+			return serverTypes[i].Zone < serverTypes[j].Zone
 		})
 
 		return serverTypes, nil
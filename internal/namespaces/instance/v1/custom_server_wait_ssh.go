@@ -0,0 +1,161 @@
+package instance
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/logger"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const (
+	sshDefaultPort       = 22
+	sshDialTimeout       = 5 * time.Second
+	sshWaitRetryInterval = 5 * time.Second
+)
+
+type serverWaitSSHRequest struct {
+	Zone     scw.Zone
+	ServerID string
+	Port     uint
+	Timeout  time.Duration
+}
+
+func serverWaitSSHCommand() *core.Command {
+	return &core.Command{
+		Short: `Wait for a server to accept SSH connections`,
+		Long: `Wait for a server to be running and for its SSH server to answer on its public IP.
+This is useful in provisioning scripts to run commands on a server as soon as it has booted.`,
+		Namespace: "instance",
+		Resource:  "server",
+		Verb:      "wait-ssh",
+		Groups:    []string{"workflow"},
+		ArgsType:  reflect.TypeOf(serverWaitSSHRequest{}),
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, err error) {
+			args := argsI.(*serverWaitSSHRequest)
+
+			return waitForServerSSH(ctx, args.Zone, args.ServerID, args.Port, args.Timeout)
+		},
+		ArgSpecs: core.ArgSpecs{
+			core.WaitTimeoutArgSpec(serverActionTimeout),
+			{
+				Name:       "server-id",
+				Short:      `ID of the server to wait for`,
+				Required:   true,
+				Positional: true,
+			},
+			{
+				Name:    "port",
+				Short:   "Port of the SSH server",
+				Default: core.DefaultValueSetter(strconv.Itoa(sshDefaultPort)),
+			},
+			core.ZoneArgSpec((*instance.API)(nil).Zones()...),
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Wait for a server to accept SSH connections",
+				Raw:   "scw instance server wait-ssh 11111111-1111-1111-1111-111111111111",
+			},
+			{
+				Short: "Start a server and wait for it to accept SSH connections",
+				Raw:   "scw instance server start 11111111-1111-1111-1111-111111111111 wait-ssh=true",
+			},
+		},
+		SeeAlsos: []*core.SeeAlso{
+			{
+				Short:   "Wait for a server to reach a stable state",
+				Command: "scw instance server wait",
+			},
+		},
+	}
+}
+
+// waitForServerSSH waits for a server to reach a stable state then polls its SSH port until its SSH server answers.
+// The timeout applies to the whole wait.
+func waitForServerSSH(ctx context.Context, zone scw.Zone, serverID string, port uint, timeout time.Duration) (*instance.Server, error) {
+	deadline := time.Now().Add(timeout)
+
+	server, err := instance.NewAPI(core.ExtractClient(ctx)).WaitForServer(&instance.WaitForServerRequest{
+		Zone:          zone,
+		ServerID:      serverID,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: core.DefaultRetryInterval,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if server.State != instance.ServerStateRunning {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("server %s is not running", server.ID),
+			Hint: fmt.Sprintf("Start the instance with: %s instance server start %s wait-ssh=true", core.ExtractBinaryName(ctx), server.ID),
+		}
+	}
+	address := serverPublicAddress(server)
+	if address == "" {
+		return nil, &core.CliError{
+			Err:  fmt.Errorf("server %s does not have a public IP to connect to", server.ID),
+			Hint: fmt.Sprintf("Add a public IP to the instance with: %s instance server update %s ip=<ip_id>", core.ExtractBinaryName(ctx), server.ID),
+		}
+	}
+
+	hostPort := net.JoinHostPort(address, strconv.Itoa(int(port)))
+	retryInterval := sshWaitRetryInterval
+	if core.DefaultRetryInterval != nil {
+		retryInterval = *core.DefaultRetryInterval
+	}
+
+	for {
+		err := sshHandshakeStarts(ctx, hostPort)
+		if err == nil {
+			return server, nil
+		}
+		logger.Debugf("ssh server on %s is not ready: %s", hostPort, err)
+
+		if time.Now().Add(retryInterval).After(deadline) {
+			return nil, &core.CliError{
+				Err:     fmt.Errorf("timeout: SSH server of %s did not answer on %s", server.ID, hostPort),
+				Details: err.Error(),
+				Hint:    "Make sure the security group of the server allows incoming connections on the SSH port",
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// sshHandshakeStarts returns nil when the SSH server listening on an address sends its version banner.
+// A TCP connection alone is not enough as the port may be open before the SSH server is started.
+func sshHandshakeStarts(ctx context.Context, hostPort string) error {
+	dialer := &net.Dialer{Timeout: sshDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", hostPort)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	err = conn.SetReadDeadline(time.Now().Add(sshDialTimeout))
+	if err != nil {
+		return err
+	}
+	banner, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(banner, "SSH-") {
+		return fmt.Errorf("unexpected banner %q", strings.TrimSpace(banner))
+	}
+	return nil
+}
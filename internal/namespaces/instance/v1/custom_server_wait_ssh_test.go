@@ -0,0 +1,31 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/instance/v1"
+)
+
+func Test_ServerWaitSSH(t *testing.T) {
+	t.Run("Stopped server", core.Test(&core.TestConfig{
+		Commands:   instance.GetCommands(),
+		BeforeFunc: createServerBionic("Server"),
+		Cmd:        "scw instance server wait-ssh {{ .Server.ID }}",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+		AfterFunc:       deleteServer("Server"),
+		DisableParallel: true,
+	}))
+
+	t.Run("Create stopped server", core.Test(&core.TestConfig{
+		Commands: instance.GetCommands(),
+		Cmd:      "scw instance server create image=ubuntu_bionic stopped=true wait-ssh=true",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+	}))
+}
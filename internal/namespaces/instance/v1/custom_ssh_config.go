@@ -24,6 +24,7 @@ type sshConfigServer struct {
 	Name              string
 	Address           string
 	User              string
+	Tags              []string
 	PrivateNetworksID []string
 }
 
@@ -37,9 +38,33 @@ func (s sshConfigServer) InPrivateNetwork(id string) bool {
 	return false
 }
 
+// matches returns whether the server matches the name and tags filters of a request
+func (s sshConfigServer) matches(args *sshConfigInstallRequest) bool {
+	if !strings.Contains(s.Name, args.Name) {
+		return false
+	}
+	for _, tag := range args.Tags {
+		found := false
+		for _, serverTag := range s.Tags {
+			if serverTag == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 type sshConfigInstallRequest struct {
-	Zone      scw.Zone
-	ProjectID *string
+	Zone         scw.Zone
+	ProjectID    *string
+	Name         string
+	Tags         []string
+	Username     string
+	IdentityFile string
 }
 
 func sshConfigInstallCommand() *core.Command {
@@ -52,12 +77,39 @@ func sshConfigInstallCommand() *core.Command {
 		Verb:      "install-config",
 		Short: `Install a ssh config with all your servers as host
 It generate hosts for instance servers, baremetal, apple-silicon and bastions`,
-		Long:     "Path of the config will be $HOME/.ssh/scaleway.config",
+		Long: `Path of the config will be $HOME/.ssh/scaleway.config, it is included in $HOME/.ssh/config so that servers can be reached with ssh <server-name>.
+The config is generated again on each run, run the command again to add new servers or to update their IPs.`,
 		ArgsType: reflect.TypeOf(sshConfigInstallRequest{}),
 		ArgSpecs: core.ArgSpecs{
+			{
+				Name:  "name",
+				Short: "Only add servers whose name contains this value",
+			},
+			{
+				Name:  "tags.{index}",
+				Short: "Only add servers with all these tags",
+			},
+			{
+				Name:  "username",
+				Short: "Username used to connect to the servers, root by default or Administrator on Windows servers",
+			},
+			{
+				Name:  "identity-file",
+				Short: "Private key used to connect to the servers, ssh default keys are used if none is given",
+			},
 			core.ProjectIDArgSpec(),
 			core.ZoneArgSpec(availableZones...),
 		},
+		Examples: []*core.Example{
+			{
+				Short: "Add all servers to the ssh config",
+				Raw:   "scw instance ssh install-config",
+			},
+			{
+				Short: "Add servers tagged web, connecting with a dedicated key",
+				Raw:   "scw instance ssh install-config tags.0=web identity-file=~/.ssh/id_web",
+			},
+		},
 		Run: func(ctx context.Context, argsI interface{}) (interface{}, error) {
 			args := argsI.(*sshConfigInstallRequest)
 			homeDir := core.ExtractUserHomeDir(ctx)
@@ -82,6 +134,19 @@ It generate hosts for instance servers, baremetal, apple-silicon and bastions`,
 			}
 			servers = append(servers, siliconServers...)
 
+			// Keep servers matching filters
+			filteredServers := []sshConfigServer(nil)
+			for _, server := range servers {
+				if !server.matches(args) {
+					continue
+				}
+				if args.Username != "" {
+					server.User = args.Username
+				}
+				filteredServers = append(filteredServers, server)
+			}
+			servers = filteredServers
+
 			// Fill hosts with servers
 			hosts := make([]sshconfig.Host, 0, len(servers))
 			for _, server := range servers {
@@ -89,8 +154,10 @@ It generate hosts for instance servers, baremetal, apple-silicon and bastions`,
 					continue
 				}
 				hosts = append(hosts, sshconfig.SimpleHost{
-					Name:    server.Name,
-					Address: server.Address,
+					Name:         server.Name,
+					Address:      server.Address,
+					User:         server.User,
+					IdentityFile: args.IdentityFile,
 				})
 			}
 
@@ -182,14 +249,11 @@ func sshConfigListServers(ctx context.Context, args *sshConfigInstallRequest) ([
 			pnIDs[j] = nic.PrivateNetworkID
 		}
 
-		serverAddress := ""
-		if server.PublicIP != nil {
-			serverAddress = server.PublicIP.Address.String()
-		}
-
 		servers[i] = sshConfigServer{
 			Name:              server.Name,
-			Address:           serverAddress,
+			Address:           serverPublicAddress(server),
+			User:              serverSSHUsername(server),
+			Tags:              server.Tags,
 			PrivateNetworksID: pnIDs,
 		}
 	}
@@ -244,6 +308,7 @@ func sshConfigListBaremetalServers(ctx context.Context, args *sshConfigInstallRe
 		servers[i] = sshConfigServer{
 			Name:              server.Name,
 			Address:           address,
+			Tags:              server.Tags,
 			PrivateNetworksID: pnIDs,
 		}
 	}
@@ -318,9 +383,10 @@ func sshConfigBastionHosts(ctx context.Context, args *sshConfigInstallRequest, s
 			for _, server := range servers {
 				if server.InPrivateNetwork(network.PrivateNetworkID) {
 					bastionHost.Hosts = append(bastionHost.Hosts, sshconfig.SimpleHost{
-						Name:    server.Name,
-						Address: server.Address,
-						User:    server.User,
+						Name:         server.Name,
+						Address:      server.Address,
+						User:         server.User,
+						IdentityFile: args.IdentityFile,
 					})
 				}
 			}
@@ -89,4 +89,56 @@ func Test_SSHConfigInstall(t *testing.T) {
 		),
 		AfterFunc: deleteServer("Server"),
 	}))
+
+	t.Run("Install config with username and identity file", core.Test(&core.TestConfig{
+		TmpHomeDir: true,
+		Commands:   instance.GetCommands(),
+		BeforeFunc: createServerBionic("Server"),
+		Args:       []string{"scw", "instance", "ssh", "install-config", "username=ubuntu", "identity-file=~/.ssh/id_web"},
+		Check: core.TestCheckCombine(
+			core.TestCheckGoldenAndReplacePatterns(
+				core.GoldenReplacement{
+					Pattern:     regexp.MustCompile("generated to .*scaleway.config"),
+					Replacement: "generated to /tmp/scw/.ssh/scaleway.config",
+				},
+			),
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				server := ctx.Meta["Server"].(*instanceSDK.Server)
+
+				configPath := sshconfig.ConfigFilePath(ctx.Meta["HOME"].(string))
+				content, err := os.ReadFile(configPath)
+				assert.Nil(t, err)
+				assert.Contains(t, string(content), "Host "+server.Name)
+				assert.Contains(t, string(content), "User ubuntu")
+				assert.Contains(t, string(content), "IdentityFile ~/.ssh/id_web")
+			},
+		),
+		AfterFunc: deleteServer("Server"),
+	}))
+
+	t.Run("Install config filtered by tags", core.Test(&core.TestConfig{
+		TmpHomeDir: true,
+		Commands:   instance.GetCommands(),
+		BeforeFunc: createServerBionic("Server"),
+		Args:       []string{"scw", "instance", "ssh", "install-config", "tags.0=web"},
+		Check: core.TestCheckCombine(
+			core.TestCheckGoldenAndReplacePatterns(
+				core.GoldenReplacement{
+					Pattern:     regexp.MustCompile("generated to .*scaleway.config"),
+					Replacement: "generated to /tmp/scw/.ssh/scaleway.config",
+				},
+			),
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				server := ctx.Meta["Server"].(*instanceSDK.Server)
+
+				configPath := sshconfig.ConfigFilePath(ctx.Meta["HOME"].(string))
+				content, err := os.ReadFile(configPath)
+				assert.Nil(t, err)
+				assert.NotContains(t, string(content), "Host "+server.Name)
+			},
+		),
+		AfterFunc: deleteServer("Server"),
+	}))
 }
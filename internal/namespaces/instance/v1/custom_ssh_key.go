@@ -12,6 +12,8 @@ import (
 
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
 	"github.com/scaleway/scaleway-cli/v2/internal/human"
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -88,12 +90,15 @@ Lookup /root/.ssh/authorized_keys on your server for more information`,
 			},
 			{
 				Name:  "public-key",
-				Short: "Public key you want to add to your server",
+				Short: "Public key you want to add to your server, keys of the project are selected from a list when it is missing in interactive mode",
 			},
 			core.ZoneArgSpec(((*instance.API)(nil)).Zones()...),
 		},
 		Run: func(ctx context.Context, argsI interface{}) (interface{}, error) {
 			args := argsI.(*sshAddKeyRequest)
+			if args.PublicKey == "" && !interactive.IsInteractive {
+				return nil, core.MissingRequiredArgumentError("public-key")
+			}
 			api := instance.NewAPI(core.ExtractClient(ctx))
 
 			server, err := api.GetServer(&instance.GetServerRequest{
@@ -104,15 +109,26 @@ Lookup /root/.ssh/authorized_keys on your server for more information`,
 				return nil, fmt.Errorf("failed to fetch server: %w", err)
 			}
 
-			formattedKey := FormatSSHKeyToTag(args.PublicKey)
+			tags := server.Server.Tags
+			if args.PublicKey == "" {
+				keys, err := promptProjectSSHKeys(ctx, server.Server)
+				if err != nil {
+					return nil, err
+				}
+				for _, key := range keys {
+					tags = append(tags, FormatSSHKeyToTag(key))
+				}
+			} else {
+				formattedKey := FormatSSHKeyToTag(args.PublicKey)
 
-			for i, tag := range server.Server.Tags {
-				if tag == formattedKey {
-					return nil, fmt.Errorf("key already exists (tags.%d)", i)
+				for i, tag := range server.Server.Tags {
+					if tag == formattedKey {
+						return nil, fmt.Errorf("key already exists (tags.%d)", i)
+					}
 				}
-			}
 
-			tags := append(server.Server.Tags, formattedKey)
+				tags = append(tags, formattedKey)
+			}
 
 			_, err = api.UpdateServer(&instance.UpdateServerRequest{
 				Zone:     args.Zone,
@@ -131,6 +147,56 @@ Lookup /root/.ssh/authorized_keys on your server for more information`,
 	}
 }
 
+// promptProjectSSHKeys asks the user to select the SSH keys of the project of a server to add to it.
+// Keys the server already has are not listed.
+func promptProjectSSHKeys(ctx context.Context, server *instance.Server) ([]string, error) {
+	resp, err := iam.NewAPI(core.ExtractClient(ctx)).ListSSHKeys(&iam.ListSSHKeysRequest{
+		ProjectID: &server.Project,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ssh keys: %w", err)
+	}
+
+	keys := []string(nil)
+	choices := []string(nil)
+	for _, key := range resp.SSHKeys {
+		formattedKey := FormatSSHKeyToTag(key.PublicKey)
+		alreadyAdded := false
+		for _, tag := range server.Tags {
+			if tag == formattedKey {
+				alreadyAdded = true
+				break
+			}
+		}
+		if key.Disabled || alreadyAdded {
+			continue
+		}
+		keys = append(keys, key.PublicKey)
+		choices = append(choices, fmt.Sprintf("%s (%s)", key.Name, key.Fingerprint))
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no ssh key of the project to add, use public-key to add another key")
+	}
+
+	indexes, err := interactive.PromptMultiSelectWithConfig(&interactive.PromptMultiSelectConfig{
+		Ctx:     ctx,
+		Prompt:  "Select the ssh keys to add to " + server.Name,
+		Choices: choices,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(indexes) == 0 {
+		return nil, fmt.Errorf("no ssh key selected")
+	}
+
+	selectedKeys := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		selectedKeys = append(selectedKeys, keys[i])
+	}
+	return selectedKeys, nil
+}
+
 type sshListKeysRequest struct {
 	Zone     scw.Zone
 	ServerID string
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -90,3 +93,95 @@ func userDataListBuilder(c *core.Command) *core.Command {
 
 	return c
 }
+
+type userDataDumpRequest struct {
+	Zone      scw.Zone
+	ServerID  string
+	Directory string
+}
+
+type userDataDumpResult struct {
+	Key  string `json:"key"`
+	Path string `json:"path"`
+}
+
+func userDataDumpCommand() *core.Command {
+	return &core.Command{
+		Short: `Write all the user data of an Instance to files`,
+		Long: `Write each user data of an Instance to a file of a directory, named after its key.
+The files can be edited and set back with scw instance user-data set key=<key> content=@<file>.`,
+		Namespace: "instance",
+		Resource:  "user-data",
+		Verb:      "dump",
+		ArgsType:  reflect.TypeOf(userDataDumpRequest{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:     "server-id",
+				Short:    "UUID of the Instance",
+				Required: true,
+			},
+			{
+				Name:    "directory",
+				Short:   "Directory to write the files to, it is created if it does not exist",
+				Default: core.DefaultValueSetter("."),
+			},
+			core.ZoneArgSpec((*instance.API)(nil).Zones()...),
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Write the user data of an Instance to the user-data directory",
+				Raw:   "scw instance user-data dump server-id=11111111-1111-1111-1111-111111111111 directory=./user-data",
+			},
+		},
+		SeeAlsos: []*core.SeeAlso{
+			{
+				Short:   "Set a user data from a file",
+				Command: "scw instance user-data set",
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (interface{}, error) {
+			args := argsI.(*userDataDumpRequest)
+			api := instance.NewAPI(core.ExtractClient(ctx))
+
+			res, err := api.GetAllServerUserData(&instance.GetAllServerUserDataRequest{
+				Zone:     args.Zone,
+				ServerID: args.ServerID,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return nil, err
+			}
+
+			err = os.MkdirAll(args.Directory, 0o755)
+			if err != nil {
+				return nil, err
+			}
+
+			results := []*userDataDumpResult(nil)
+			for key, value := range res.UserData {
+				// Keys are used as file names, they must not escape the directory
+				if key != filepath.Base(key) || key == "." || key == ".." {
+					return nil, fmt.Errorf("cannot write user data %q to a file", key)
+				}
+
+				content, err := io.ReadAll(value)
+				if err != nil {
+					return nil, err
+				}
+				path := filepath.Join(args.Directory, key)
+				err = os.WriteFile(path, content, 0o600)
+				if err != nil {
+					return nil, err
+				}
+				results = append(results, &userDataDumpResult{
+					Key:  key,
+					Path: path,
+				})
+			}
+			sort.Slice(results, func(i, j int) bool {
+				return results[i].Key < results[j].Key
+			})
+
+			return results, nil
+		},
+	}
+}
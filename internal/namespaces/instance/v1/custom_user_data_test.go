@@ -2,8 +2,12 @@ package instance_test
 
 import (
 	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
+	"github.com/alecthomas/assert"
+
 	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/instance/v1"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
@@ -53,6 +57,36 @@ func Test_UserDataList(t *testing.T) {
 	}))
 }
 
+func Test_UserDataDump(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		BeforeFunc: core.BeforeFuncCombine(
+			createServerBionic("Server"),
+			core.ExecBeforeCmd("scw instance user-data set server-id={{ .Server.ID }} key=foo content=bar"),
+			core.ExecBeforeCmd("scw instance user-data set server-id={{ .Server.ID }} key=bar content=foo"),
+		),
+		TmpHomeDir: true,
+		Commands:   instance.GetCommands(),
+		Cmd:        "scw instance user-data dump server-id={{ .Server.ID }} directory={{ .HOME }}/user-data",
+		AfterFunc:  deleteServer("Server"),
+		Check: core.TestCheckCombine(
+			core.TestCheckGoldenAndReplacePatterns(
+				core.GoldenReplacement{
+					Pattern:       regexp.MustCompile(`/[^ "]*/user-data/`),
+					Replacement:   "/tmp/scw/user-data/",
+					OptionalMatch: true,
+				},
+			),
+			core.TestCheckExitCode(0),
+			func(t *testing.T, ctx *core.CheckFuncCtx) {
+				for _, key := range []string{"foo", "bar"} {
+					_, err := os.Stat(filepath.Join(ctx.Meta["HOME"].(string), "user-data", key))
+					assert.Nil(t, err)
+				}
+			},
+		),
+	}))
+}
+
 func Test_UserDataFileUpload(t *testing.T) {
 	content := "cloud-init file content"
 
@@ -99,6 +99,22 @@ func volumeListBuilder(c *core.Command) *core.Command {
 	return c
 }
 
+func volumeDeleteBuilder(c *core.Command) *core.Command {
+	c.Destructive = true
+	c.DangerousResourceNameFunc = func(ctx context.Context, argsI interface{}) (string, error) {
+		request := argsI.(*instance.DeleteVolumeRequest)
+		volume, err := instance.NewAPI(core.ExtractClient(ctx)).GetVolume(&instance.GetVolumeRequest{
+			Zone:     request.Zone,
+			VolumeID: request.VolumeID,
+		})
+		if err != nil {
+			return "", err
+		}
+		return volume.Volume.Name, nil
+	}
+	return c
+}
+
 type volumeWaitRequest struct {
 	Zone     scw.Zone
 	VolumeID string
@@ -46,6 +46,7 @@ func volumeTypeListBuilder(cmd *core.Command) *core.Command {
 			{FieldName: "Constraints.Min", Label: "Min"},
 			{FieldName: "Constraints.Max", Label: "Max"},
 		},
+		IDField: "Type",
 	}
 
 	return cmd
@@ -2,6 +2,7 @@ package instance
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	"github.com/scaleway/scaleway-cli/v2/internal/core"
@@ -9,24 +10,23 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
-func completeServerType(ctx context.Context, prefix string, createReq any) core.AutocompleteSuggestions {
+// listServerTypes lists the commercial types available in the zone of a server creation.
+func listServerTypes(ctx context.Context, createReq any) ([]string, error) {
 	req := createReq.(*instanceCreateServerRequest)
 	resp, err := instance.NewAPI(core.ExtractClient(ctx)).ListServersTypes(&instance.ListServersTypesRequest{
 		Zone: req.Zone,
 	}, scw.WithAllPages())
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
-	suggestions := make([]string, 0, len(resp.Servers))
-
+	serverTypes := make([]string, 0, len(resp.Servers))
 	for serverType := range resp.Servers {
-		if strings.HasPrefix(serverType, prefix) {
-			suggestions = append(suggestions, serverType)
-		}
+		serverTypes = append(serverTypes, serverType)
 	}
+	sort.Strings(serverTypes)
 
-	return suggestions
+	return serverTypes, nil
 }
 
 func commercialTypeIsWindowsServer(commercialType string) bool {
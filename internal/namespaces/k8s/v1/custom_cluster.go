@@ -315,6 +315,17 @@ func clusterGetBuilder(c *core.Command) *core.Command {
 }
 
 func clusterDeleteBuilder(c *core.Command) *core.Command {
+	c.DangerousResourceNameFunc = func(ctx context.Context, argsI interface{}) (string, error) {
+		request := argsI.(*k8s.DeleteClusterRequest)
+		cluster, err := k8s.NewAPI(core.ExtractClient(ctx)).GetCluster(&k8s.GetClusterRequest{
+			Region:    request.Region,
+			ClusterID: request.ClusterID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return cluster.Name, nil
+	}
 	c.WaitFunc = waitForClusterFunc(clusterActionDelete)
 	return c
 }
@@ -22,6 +22,9 @@ func versionListBuilder(c *core.Command) *core.Command {
 		return versionsResponse.Versions, nil
 	})
 
+	// Versions are identified by their name
+	c.View.IDField = "Name"
+
 	return c
 }
 
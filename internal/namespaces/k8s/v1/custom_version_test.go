@@ -30,4 +30,13 @@ func Test_ListVersion_Basic(t *testing.T) {
 			core.TestCheckGolden(),
 		),
 	}))
+
+	t.Run("ids", core.Test(&core.TestConfig{
+		Commands: k8s.GetCommands(),
+		Cmd:      "scw k8s version list -o ids",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+	}))
 }
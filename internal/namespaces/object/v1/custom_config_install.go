@@ -61,6 +61,8 @@ func configInstallCommand() *core.Command {
 				Command: "scw object config get",
 			},
 		},
+		// The config file is written without any API request
+		DisableDryRun: true,
 		Run: func(ctx context.Context, argsI interface{}) (interface{}, error) {
 			args := argsI.(*installArgs)
 
@@ -359,7 +359,9 @@ func backupDownloadCommand() *core.Command {
 			defer out.Close()
 
 			// Write the body to file
-			size, err := io.Copy(out, res.Body)
+			progress := interactive.NewProgressReader(res.Body, "Downloading "+filename, res.ContentLength)
+			size, err := io.Copy(out, progress)
+			progress.Finish()
 			if err != nil {
 				return nil, err
 			}
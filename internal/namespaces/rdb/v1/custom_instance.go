@@ -165,12 +165,8 @@ func instanceCloneBuilder(c *core.Command) *core.Command {
 	return c
 }
 
-// Caching ListNodeType response for shell completion
-var completeListNodeTypeCache *rdbSDK.ListNodeTypesResponse
-
-var completeListEngineCache *rdbSDK.ListDatabaseEnginesResponse
-
-func autoCompleteNodeType(ctx context.Context, prefix string, request any) core.AutocompleteSuggestions {
+// listNodeTypes lists the node types available in the region of a database instance.
+func listNodeTypes(ctx context.Context, request any) ([]string, error) {
 	region := scw.Region("")
 	switch req := request.(type) {
 	case *rdbSDK.CreateInstanceRequest:
@@ -179,53 +175,37 @@ func autoCompleteNodeType(ctx context.Context, prefix string, request any) core.
 		region = req.Region
 	}
 
-	suggestions := core.AutocompleteSuggestions(nil)
-
-	client := core.ExtractClient(ctx)
-	api := rdbSDK.NewAPI(client)
-
-	if completeListNodeTypeCache == nil {
-		res, err := api.ListNodeTypes(&rdbSDK.ListNodeTypesRequest{
-			Region: region,
-		}, scw.WithAllPages())
-		if err != nil {
-			return nil
-		}
-		completeListNodeTypeCache = res
+	res, err := rdbSDK.NewAPI(core.ExtractClient(ctx)).ListNodeTypes(&rdbSDK.ListNodeTypesRequest{
+		Region: region,
+	}, scw.WithAllPages())
+	if err != nil {
+		return nil, err
 	}
 
-	for _, nodeType := range completeListNodeTypeCache.NodeTypes {
-		if strings.HasPrefix(nodeType.Name, prefix) {
-			suggestions = append(suggestions, nodeType.Name)
-		}
+	nodeTypes := make([]string, 0, len(res.NodeTypes))
+	for _, nodeType := range res.NodeTypes {
+		nodeTypes = append(nodeTypes, nodeType.Name)
 	}
 
-	return suggestions
+	return nodeTypes, nil
 }
 
-func autoCompleteDatabaseEngines(ctx context.Context, prefix string, request any) core.AutocompleteSuggestions {
+// listDatabaseEngines lists the database engines available in the region of a database instance.
+func listDatabaseEngines(ctx context.Context, request any) ([]string, error) {
 	req := request.(*rdbSDK.CreateInstanceRequest)
-	suggestion := core.AutocompleteSuggestions(nil)
-	client := core.ExtractClient(ctx)
-	api := rdbSDK.NewAPI(client)
-
-	if completeListEngineCache == nil {
-		res, err := api.ListDatabaseEngines(&rdbSDK.ListDatabaseEnginesRequest{
-			Region: req.Region,
-		}, scw.WithAllPages())
-		if err != nil {
-			return nil
-		}
-		completeListEngineCache = res
+	res, err := rdbSDK.NewAPI(core.ExtractClient(ctx)).ListDatabaseEngines(&rdbSDK.ListDatabaseEnginesRequest{
+		Region: req.Region,
+	}, scw.WithAllPages())
+	if err != nil {
+		return nil, err
 	}
 
-	for _, engine := range completeListEngineCache.Engines {
-		if strings.HasPrefix(engine.Name, prefix) {
-			suggestion = append(suggestion, engine.Name)
-		}
+	engines := make([]string, 0, len(res.Engines))
+	for _, engine := range res.Engines {
+		engines = append(engines, engine.Name)
 	}
 
-	return suggestion
+	return engines, nil
 }
 
 func instanceCreateBuilder(c *core.Command) *core.Command {
@@ -257,8 +237,8 @@ func instanceCreateBuilder(c *core.Command) *core.Command {
 	})
 	c.ArgSpecs.GetByName("password").Required = false
 	c.ArgSpecs.GetByName("node-type").Default = core.DefaultValueSetter("DB-DEV-S")
-	c.ArgSpecs.GetByName("node-type").AutoCompleteFunc = autoCompleteNodeType
-	c.ArgSpecs.GetByName("engine").AutoCompleteFunc = autoCompleteDatabaseEngines
+	c.ArgSpecs.GetByName("node-type").EnumValuesFunc = listNodeTypes
+	c.ArgSpecs.GetByName("engine").EnumValuesFunc = listDatabaseEngines
 
 	c.ArgsType = reflect.TypeOf(rdbCreateInstanceRequestCustom{})
 
@@ -385,7 +365,7 @@ func instanceGetBuilder(c *core.Command) *core.Command {
 }
 
 func instanceUpgradeBuilder(c *core.Command) *core.Command {
-	c.ArgSpecs.GetByName("node-type").AutoCompleteFunc = autoCompleteNodeType
+	c.ArgSpecs.GetByName("node-type").EnumValuesFunc = listNodeTypes
 
 	c.WaitFunc = func(ctx context.Context, _, respI interface{}) (interface{}, error) {
 		api := rdbSDK.NewAPI(core.ExtractClient(ctx))
@@ -53,7 +53,8 @@ It avoid running docker login commands.
 				},
 			},
 		},
-		Run: registrySetupDockerHelperRun,
+		Run:           registrySetupDockerHelperRun,
+		DisableDryRun: true,
 	}
 }
 
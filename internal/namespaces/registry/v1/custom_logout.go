@@ -31,7 +31,8 @@ You will need to have the chosen binary installed on your system and in your PAT
 			},
 			core.RegionArgSpec(),
 		},
-		Run: registryLogoutRun,
+		Run:           registryLogoutRun,
+		DisableDryRun: true,
 	}
 }
 
@@ -0,0 +1,104 @@
+package search
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+func GetCommands() *core.Commands {
+	return core.NewCommands(
+		searchCommand(),
+	)
+}
+
+type searchArgs struct {
+	Query string
+}
+
+type searchResult struct {
+	Command string `json:"command"`
+	Short   string `json:"short"`
+
+	// titleMatch is set when the query is found in the command line or its short description
+	titleMatch bool
+}
+
+func searchCommand() *core.Command {
+	return &core.Command{
+		Groups:               []string{"utility"},
+		Short:                "Search commands",
+		Long:                 "Search the commands whose path, description or arguments contain every word of the query, ignoring case.",
+		Namespace:            "search",
+		AllowAnonymousClient: true,
+		ArgsType:             reflect.TypeOf(searchArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:       "query",
+				Short:      "Words to search",
+				Required:   true,
+				Positional: true,
+			},
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Search the commands about reverse DNS",
+				Raw:   `scw search "reverse dns"`,
+			},
+		},
+		View: &core.View{
+			Fields: []*core.ViewField{
+				{FieldName: "Command", Label: "Command"},
+				{FieldName: "Short", Label: "Short"},
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (interface{}, error) {
+			args := argsI.(*searchArgs)
+			words := strings.Fields(strings.ToLower(args.Query))
+			binaryName := core.ExtractBinaryName(ctx)
+
+			results := []*searchResult(nil)
+			for _, cmd := range core.ExtractCommands(ctx).GetSortedCommand() {
+				if cmd.Hidden {
+					continue
+				}
+
+				commandLine := cmd.GetCommandLine(binaryName)
+				title := strings.ToLower(commandLine + " " + cmd.Short)
+				text := strings.ToLower(title + " " + cmd.Long)
+				for _, argSpec := range cmd.ArgSpecs {
+					text += " " + strings.ToLower(argSpec.Name+" "+argSpec.Short)
+				}
+
+				if !containsAll(text, words) {
+					continue
+				}
+				results = append(results, &searchResult{
+					Command:    commandLine,
+					Short:      cmd.Short,
+					titleMatch: containsAll(title, words),
+				})
+			}
+
+			// Commands matching by their name or description are more relevant than the ones matching by an argument
+			sort.SliceStable(results, func(i, j int) bool {
+				return results[i].titleMatch && !results[j].titleMatch
+			})
+
+			return results, nil
+		},
+	}
+}
+
+// containsAll returns whether text contains every word
+func containsAll(text string, words []string) bool {
+	for _, word := range words {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+	return true
+}
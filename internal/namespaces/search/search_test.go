@@ -0,0 +1,59 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/search"
+)
+
+func testCommands() *core.Commands {
+	cmds := search.GetCommands()
+	cmds.Merge(core.NewCommands(
+		&core.Command{
+			Namespace: "instance",
+			Resource:  "ip",
+			Verb:      "update",
+			Short:     "Update a flexible IP",
+			ArgSpecs: core.ArgSpecs{
+				{
+					Name:  "reverse",
+					Short: "Reverse DNS of the IP",
+				},
+			},
+		},
+		&core.Command{
+			Namespace: "domain",
+			Resource:  "reverse-dns",
+			Verb:      "get",
+			Short:     "Get the reverse DNS of an IP",
+		},
+		&core.Command{
+			Namespace: "instance",
+			Resource:  "server",
+			Verb:      "list",
+			Short:     "List servers",
+		},
+	))
+	return cmds
+}
+
+func Test_Search(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands: testCommands(),
+		Args:     []string{"scw", "search", "reverse dns"},
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+	}))
+
+	t.Run("No result", core.Test(&core.TestConfig{
+		Commands: testCommands(),
+		Cmd:      "scw search kubernetes",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+	}))
+}
@@ -18,6 +18,7 @@ func shellCommand() *core.Command {
 	return &core.Command{
 		Groups:               []string{"utility"},
 		Short:                "Start shell mode",
+		Long:                 "Start an interactive shell running commands without the scw prefix, with completion of commands and arguments.\nThe commands entered are kept in the history of the next shells, in the cache directory.",
 		Namespace:            "shell",
 		AllowAnonymousClient: false,
 		ArgsType:             reflect.TypeOf(args.RawArgs{}),
@@ -0,0 +1,191 @@
+package update
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+const (
+	githubReleasesURL  = "https://api.github.com/repos/scaleway/scaleway-cli/releases"
+	checksumsAssetName = "SHA256SUMS"
+)
+
+type release struct {
+	TagName string          `json:"tag_name"`
+	Draft   bool            `json:"draft"`
+	Assets  []*releaseAsset `json:"assets"`
+}
+
+type releaseAsset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+func (r *release) version() (*version.Version, error) {
+	return version.NewSemver(strings.TrimPrefix(r.TagName, "v"))
+}
+
+// binaryAsset returns the binary built for the current OS and architecture, e.g. scaleway-cli_2.5.4_linux_amd64
+func (r *release) binaryAsset() *releaseAsset {
+	suffix := fmt.Sprintf("_%s_%s", runtime.GOOS, runtime.GOARCH)
+	if runtime.GOOS == "windows" {
+		suffix += ".exe"
+	}
+	return r.asset(func(name string) bool {
+		return strings.HasSuffix(name, suffix)
+	})
+}
+
+func (r *release) checksumsAsset() *releaseAsset {
+	return r.asset(func(name string) bool {
+		return name == checksumsAssetName
+	})
+}
+
+func (r *release) asset(match func(name string) bool) *releaseAsset {
+	for _, asset := range r.Assets {
+		if match(asset.Name) {
+			return asset
+		}
+	}
+	return nil
+}
+
+// getLatestRelease returns the latest published release of a channel.
+// The stable channel only contains releases, the prerelease one also contains release candidates.
+func getLatestRelease(ctx context.Context, client *http.Client, channel string) (*release, error) {
+	if channel == channelStable {
+		latest := &release{}
+		err := getJSON(ctx, client, githubReleasesURL+"/latest", latest)
+		if err != nil {
+			return nil, err
+		}
+		return latest, nil
+	}
+
+	releases := []*release(nil)
+	err := getJSON(ctx, client, githubReleasesURL, &releases)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range releases {
+		if !r.Draft {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("no release found")
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, target interface{}) error {
+	resp, err := get(ctx, client, url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(target)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s: %w", url, err)
+	}
+	return nil
+}
+
+func get(ctx context.Context, client *http.Client, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to get %s: %s", url, resp.Status)
+	}
+	return resp, nil
+}
+
+// getChecksum returns the SHA256 checksum of an asset listed in the checksums file of a release
+func getChecksum(ctx context.Context, client *http.Client, checksums *releaseAsset, assetName string) (string, error) {
+	resp, err := get(ctx, client, checksums.BrowserDownloadURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	// Each line is "<checksum>  <file name>"
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == assetName {
+			return fields[0], nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no checksum found for %s", assetName)
+}
+
+// downloadBinary downloads a binary next to the executable it replaces, so it can be renamed over it, and verifies its checksum.
+// It returns the path of the downloaded binary.
+func downloadBinary(ctx context.Context, client *http.Client, asset *releaseAsset, checksum string, executablePath string) (string, error) {
+	resp, err := get(ctx, client, asset.BrowserDownloadURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	file, err := os.CreateTemp(filepath.Dir(executablePath), ".scw-update-*")
+	if err != nil {
+		return "", err
+	}
+	downloadPath := file.Name()
+
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(file, hash), resp.Body)
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil && hex.EncodeToString(hash.Sum(nil)) != checksum {
+		err = fmt.Errorf("checksum of %s does not match the one of the release", asset.Name)
+	}
+	if err == nil {
+		err = os.Chmod(downloadPath, 0o755)
+	}
+	if err != nil {
+		_ = os.Remove(downloadPath)
+		return "", err
+	}
+
+	return downloadPath, nil
+}
+
+// replaceExecutable renames a binary over the running executable.
+// The rename is atomic, the executable is either the old or the new binary.
+// Windows does not allow replacing a running executable, it is moved aside first and removed on the next update.
+func replaceExecutable(binaryPath string, executablePath string) error {
+	if runtime.GOOS == "windows" {
+		oldPath := executablePath + ".old"
+		_ = os.Remove(oldPath)
+		err := os.Rename(executablePath, oldPath)
+		if err != nil {
+			return err
+		}
+	}
+	return os.Rename(binaryPath, executablePath)
+}
@@ -0,0 +1,68 @@
+package update
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DownloadBinary(t *testing.T) {
+	binary := []byte("new binary")
+	sum := sha256.Sum256(binary)
+	checksum := hex.EncodeToString(sum[:])
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/SHA256SUMS":
+			_, _ = w.Write([]byte("0000  scaleway-cli_2.0.0_other_arch\n" + checksum + "  scaleway-cli_2.0.0_os_arch\n"))
+		case "/scaleway-cli_2.0.0_os_arch":
+			_, _ = w.Write(binary)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	asset := &releaseAsset{
+		Name:               "scaleway-cli_2.0.0_os_arch",
+		BrowserDownloadURL: server.URL + "/scaleway-cli_2.0.0_os_arch",
+	}
+
+	t.Run("Checksum", func(t *testing.T) {
+		got, err := getChecksum(ctx, server.Client(), &releaseAsset{BrowserDownloadURL: server.URL + "/SHA256SUMS"}, asset.Name)
+		require.NoError(t, err)
+		assert.Equal(t, checksum, got)
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		executablePath := filepath.Join(t.TempDir(), "scw")
+		require.NoError(t, os.WriteFile(executablePath, []byte("old binary"), 0o755))
+
+		binaryPath, err := downloadBinary(ctx, server.Client(), asset, checksum, executablePath)
+		require.NoError(t, err)
+		require.NoError(t, replaceExecutable(binaryPath, executablePath))
+
+		content, err := os.ReadFile(executablePath)
+		require.NoError(t, err)
+		assert.Equal(t, binary, content)
+	})
+
+	t.Run("Checksum mismatch", func(t *testing.T) {
+		dir := t.TempDir()
+		_, err := downloadBinary(ctx, server.Client(), asset, "0000", filepath.Join(dir, "scw"))
+		assert.Error(t, err)
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
@@ -0,0 +1,123 @@
+package update
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+)
+
+const (
+	channelStable     = "stable"
+	channelPrerelease = "prerelease"
+)
+
+func GetCommands() *core.Commands {
+	return core.NewCommands(updateCommand())
+}
+
+type updateArgs struct {
+	Channel string
+}
+
+func updateCommand() *core.Command {
+	return &core.Command{
+		Groups: []string{"utility"},
+		Short:  `Update the CLI to its latest release`,
+		Long: `Download the latest release of the CLI for the current OS and architecture, verify its SHA256 checksum and replace the running binary.
+The binary is only replaced once downloaded and verified, an interrupted update leaves the current binary untouched.
+If the CLI was installed with a package manager, use the package manager to update it instead.`,
+		Namespace:            "update",
+		AllowAnonymousClient: true,
+		ArgsType:             reflect.TypeOf(updateArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:       "channel",
+				Short:      "Release channel, prerelease also contains release candidates",
+				Default:    core.DefaultValueSetter(channelStable),
+				EnumValues: []string{channelStable, channelPrerelease},
+			},
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Update to the latest release",
+				Raw:   "scw update",
+			},
+			{
+				Short: "Update to the latest release candidate",
+				Raw:   "scw update channel=prerelease",
+			},
+		},
+		SeeAlsos: []*core.SeeAlso{
+			{
+				Short:   "Display the CLI version",
+				Command: "scw version",
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			args := argsI.(*updateArgs)
+
+			buildInfo := core.ExtractBuildInfo(ctx)
+			if !buildInfo.IsRelease() {
+				return nil, &core.CliError{
+					Err:  fmt.Errorf("only released binaries can be updated"),
+					Hint: "This binary was built from source, rebuild it from the latest sources to update it.",
+				}
+			}
+
+			executablePath, err := os.Executable()
+			if err != nil {
+				return nil, err
+			}
+			executablePath, err = filepath.EvalSymlinks(executablePath)
+			if err != nil {
+				return nil, err
+			}
+
+			client := core.ExtractHTTPClient(ctx)
+			latest, err := getLatestRelease(ctx, client, args.Channel)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the latest release: %w", err)
+			}
+			latestVersion, err := latest.version()
+			if err != nil {
+				return nil, err
+			}
+			if !buildInfo.Version.LessThan(latestVersion) {
+				return &core.SuccessResult{
+					Message: fmt.Sprintf("scw is already up to date (%s)", buildInfo.Version),
+				}, nil
+			}
+
+			binary := latest.binaryAsset()
+			checksums := latest.checksumsAsset()
+			if binary == nil || checksums == nil {
+				return nil, fmt.Errorf("release %s has no binary for this platform", latest.TagName)
+			}
+			checksum, err := getChecksum(ctx, client, checksums, binary.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			binaryPath, err := downloadBinary(ctx, client, binary, checksum, executablePath)
+			if err != nil {
+				return nil, err
+			}
+			err = replaceExecutable(binaryPath, executablePath)
+			if err != nil {
+				_ = os.Remove(binaryPath)
+				return nil, &core.CliError{
+					Err:  fmt.Errorf("failed to replace %s: %w", executablePath, err),
+					Hint: "Make sure you can write to the directory of the binary, you may need to run the update as root.",
+				}
+			}
+
+			return &core.SuccessResult{
+				Message: fmt.Sprintf("scw updated from %s to %s", buildInfo.Version, latestVersion),
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package update_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/update"
+)
+
+func Test_Update(t *testing.T) {
+	t.Run("Built from source", core.Test(&core.TestConfig{
+		Commands: update.GetCommands(),
+		Cmd:      "scw update",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(1),
+		),
+	}))
+}
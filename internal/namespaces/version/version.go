@@ -11,16 +11,76 @@ func GetCommands() *core.Commands {
 	return core.NewCommands(versionCommand())
 }
 
+type versionArgs struct {
+	CheckUpdate bool
+}
+
+type versionResult struct {
+	Version   string `json:"version"`
+	GitCommit string `json:"git_commit"`
+	GitBranch string `json:"git_branch"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+	GoOS      string `json:"go_os"`
+	GoArch    string `json:"go_arch"`
+	// LatestVersion is only set when the latest release could be checked
+	LatestVersion   string `json:"latest_version,omitempty"`
+	UpdateAvailable bool   `json:"update_available"`
+}
+
 func versionCommand() *core.Command {
 	return &core.Command{
-		Groups:               []string{"utility"},
-		Short:                `Display cli version`,
+		Groups: []string{"utility"},
+		Short:  `Display cli version`,
+		Long: `Display the version of the CLI and how it was built: git commit, build date and Go version.
+Official releases also check whether a newer release is available, unless check-update=false is given or SCW_DISABLE_CHECK_VERSION is set to true.
+Please include this output when reporting a bug.`,
 		Namespace:            "version",
 		AllowAnonymousClient: true,
-		ArgsType:             reflect.TypeOf(struct{}{}),
-		ArgSpecs:             core.ArgSpecs{},
-		Run: func(ctx context.Context, _ interface{}) (i interface{}, e error) {
-			return core.ExtractBuildInfo(ctx), nil
+		ArgsType:             reflect.TypeOf(versionArgs{}),
+		ArgSpecs: core.ArgSpecs{
+			{
+				Name:    "check-update",
+				Short:   "Check whether a newer release of the CLI is available",
+				Default: core.DefaultValueSetter("true"),
+			},
+		},
+		Examples: []*core.Example{
+			{
+				Short: "Display the version without checking for a newer release",
+				Raw:   "scw version check-update=false",
+			},
+		},
+		Run: func(ctx context.Context, argsI interface{}) (i interface{}, e error) {
+			args := argsI.(*versionArgs)
+			buildInfo := core.ExtractBuildInfo(ctx)
+
+			result := &versionResult{
+				GitCommit: buildInfo.GitCommit,
+				GitBranch: buildInfo.GitBranch,
+				BuildDate: buildInfo.BuildDate,
+				GoVersion: buildInfo.GoVersion,
+				GoOS:      buildInfo.GoOS,
+				GoArch:    buildInfo.GoArch,
+			}
+			if buildInfo.Version != nil {
+				result.Version = buildInfo.Version.String()
+			}
+
+			if !args.CheckUpdate || !buildInfo.CanCheckVersion(ctx) {
+				return result, nil
+			}
+
+			// Being offline must not prevent from displaying the version
+			latestVersion, err := core.GetLatestVersion(ctx)
+			if err != nil {
+				core.ExtractLogger(ctx).Debugf("failed to retrieve latest version: %s\n", err)
+				return result, nil
+			}
+			result.LatestVersion = latestVersion.String()
+			result.UpdateAvailable = buildInfo.Version.LessThan(latestVersion)
+
+			return result, nil
 		},
 	}
 }
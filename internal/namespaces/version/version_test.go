@@ -0,0 +1,28 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/core"
+	"github.com/scaleway/scaleway-cli/v2/internal/namespaces/version"
+)
+
+func Test_Version(t *testing.T) {
+	t.Run("Simple", core.Test(&core.TestConfig{
+		Commands: version.GetCommands(),
+		Cmd:      "scw version",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+	}))
+
+	t.Run("Without update check", core.Test(&core.TestConfig{
+		Commands: version.GetCommands(),
+		Cmd:      "scw version check-update=false -o json",
+		Check: core.TestCheckCombine(
+			core.TestCheckGolden(),
+			core.TestCheckExitCode(0),
+		),
+	}))
+}
@@ -0,0 +1,107 @@
+package passphrase
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// Env is the environment variable used to give the passphrase without being prompted
+	Env = "SCW_PASSPHRASE"
+
+	// prefix marks a value encrypted with a passphrase
+	prefix = "encrypted:"
+
+	saltLength = 16
+	keyLength  = 32
+
+	// scrypt parameters recommended for interactive logins
+	scryptN = 32768
+	scryptR = 8
+	scryptP = 1
+)
+
+// ErrWrongPassphrase is returned when a value cannot be decrypted with the given passphrase
+var ErrWrongPassphrase = errors.New("wrong passphrase")
+
+// IsEncrypted returns whether value has been encrypted with Encrypt
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Encrypt encrypts value using AES-GCM with a key derived from passphrase with scrypt.
+// The returned string holds the salt, the nonce and the ciphertext.
+func Encrypt(value string, passphrase string) (string, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	data := append(salt, nonce...)
+	data = gcm.Seal(data, nonce, []byte(value), nil)
+
+	return prefix + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// Decrypt decrypts a value returned by Encrypt
+func Decrypt(value string, passphrase string) (string, error) {
+	if !IsEncrypted(value) {
+		return "", fmt.Errorf("value is not encrypted")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, prefix))
+	if err != nil {
+		return "", fmt.Errorf("invalid encrypted value: %w", err)
+	}
+	if len(data) < saltLength {
+		return "", fmt.Errorf("invalid encrypted value")
+	}
+
+	gcm, err := newGCM(passphrase, data[:saltLength])
+	if err != nil {
+		return "", err
+	}
+
+	data = data[saltLength:]
+	if len(data) < gcm.NonceSize() {
+		return "", fmt.Errorf("invalid encrypted value")
+	}
+
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", ErrWrongPassphrase
+	}
+
+	return string(plaintext), nil
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, keyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,32 @@
+package passphrase_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/passphrase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	secretKey := "11111111-1111-1111-1111-111111111111"
+
+	encrypted, err := passphrase.Encrypt(secretKey, "right passphrase")
+	require.NoError(t, err)
+	assert.True(t, passphrase.IsEncrypted(encrypted))
+	assert.NotContains(t, encrypted, secretKey)
+
+	decrypted, err := passphrase.Decrypt(encrypted, "right passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, secretKey, decrypted)
+
+	_, err = passphrase.Decrypt(encrypted, "wrong passphrase")
+	assert.ErrorIs(t, err, passphrase.ErrWrongPassphrase)
+}
+
+func TestDecryptNotEncrypted(t *testing.T) {
+	assert.False(t, passphrase.IsEncrypted("11111111-1111-1111-1111-111111111111"))
+
+	_, err := passphrase.Decrypt("11111111-1111-1111-1111-111111111111", "passphrase")
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package platform
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -11,7 +12,8 @@ import (
 // Or the implementation to run in a browser (used for wasm/js build)
 type Platform interface {
 	// CreateClient returns a valid client for the current platform
-	CreateClient(client *http.Client, configPath string, profileName string) (*scw.Client, error)
+	// getenv returns the environment variables of the running command
+	CreateClient(ctx context.Context, client *http.Client, configPath string, profileName string, getenv func(string) string) (*scw.Client, error)
 
 	// ScwConfig returns a scaleway config if available, can be nil
 	// TODO: remove if possible, currently used in profile completion
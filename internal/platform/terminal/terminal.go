@@ -8,7 +8,8 @@ import (
 type Platform struct {
 	UserAgent string
 
-	cfg *scw.Config
+	cfg        *scw.Config
+	passphrase string
 }
 
 func (p *Platform) ScwConfig() *scw.Config {
@@ -23,6 +24,11 @@ func (p *Platform) SetScwConfig(cfg *scw.Config) {
 	p.cfg = cfg
 }
 
+// SetPassphrase sets the passphrase used to decrypt secret keys stored encrypted in the config
+func (p *Platform) SetPassphrase(passphrase string) {
+	p.passphrase = passphrase
+}
+
 func NewPlatform(useragent string) platform.Platform {
 	return &Platform{
 		UserAgent: useragent,
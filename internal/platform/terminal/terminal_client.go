@@ -1,18 +1,22 @@
 package terminal
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
+	"github.com/scaleway/scaleway-cli/v2/internal/interactive"
+	"github.com/scaleway/scaleway-cli/v2/internal/keyring"
+	"github.com/scaleway/scaleway-cli/v2/internal/passphrase"
 	"github.com/scaleway/scaleway-cli/v2/internal/platform"
 	"github.com/scaleway/scaleway-sdk-go/logger"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/scaleway-sdk-go/validation"
 )
 
-func (p *Platform) CreateClient(httpClient *http.Client, configPath string, profileName string) (*scw.Client, error) {
+func (p *Platform) CreateClient(ctx context.Context, httpClient *http.Client, configPath string, profileName string, getenv func(string) string) (*scw.Client, error) {
 	profile := scw.LoadEnvProfile()
 
 	// Default path is based on the following priority order:
@@ -40,6 +44,20 @@ func (p *Platform) CreateClient(httpClient *http.Client, configPath string, prof
 			return nil, err
 		}
 
+		// Environment variables override the config file before resolving its secret key,
+		// a secret key given in SCW_SECRET_KEY must not read the keyring nor ask for a passphrase
+		activeProfile = scw.MergeProfiles(activeProfile, profile)
+
+		activeProfile, err = resolveKeyringProfile(activeProfile)
+		if err != nil {
+			return nil, err
+		}
+
+		activeProfile, err = p.decryptProfile(ctx, activeProfile, getenv)
+		if err != nil {
+			return nil, err
+		}
+
 		// Creates a client from the active profile
 		// It will trigger a validation step on its configuration to catch errors if any
 		opts := []scw.ClientOption{
@@ -51,7 +69,7 @@ func (p *Platform) CreateClient(httpClient *http.Client, configPath string, prof
 			return nil, err
 		}
 
-		profile = scw.MergeProfiles(activeProfile, profile)
+		profile = activeProfile
 	}
 
 	// If profile have a defaultZone but no defaultRegion we set the defaultRegion
@@ -84,6 +102,61 @@ func (p *Platform) CreateClient(httpClient *http.Client, configPath string, prof
 	return client, validateClient(client)
 }
 
+// decryptProfile returns a copy of profile with its secret key decrypted if it was stored encrypted.
+// The passphrase is read from SCW_PASSPHRASE or prompted, it is then kept for the next clients.
+func (p *Platform) decryptProfile(ctx context.Context, profile *scw.Profile, getenv func(string) string) (*scw.Profile, error) {
+	if profile.SecretKey == nil || !passphrase.IsEncrypted(*profile.SecretKey) {
+		return profile, nil
+	}
+
+	if p.passphrase == "" {
+		p.passphrase = getenv(passphrase.Env)
+	}
+	if p.passphrase == "" {
+		var err error
+		p.passphrase, err = interactive.PromptPasswordWithConfig(&interactive.PromptPasswordConfig{
+			Ctx:    ctx,
+			Prompt: "Passphrase to decrypt the secret key",
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	secretKey, err := passphrase.Decrypt(*profile.SecretKey, p.passphrase)
+	if err != nil {
+		p.passphrase = ""
+		return nil, &platform.ClientError{
+			Err:     fmt.Errorf("cannot decrypt secret key: %w", err),
+			Details: fmt.Sprintf("The secret key is encrypted with a passphrase, it can be given with the %s environment variable.", passphrase.Env),
+		}
+	}
+
+	decrypted := *profile
+	decrypted.SecretKey = &secretKey
+	return &decrypted, nil
+}
+
+// resolveKeyringProfile returns a copy of profile with its secret key read from the system keyring
+// if the config only holds a reference to it.
+func resolveKeyringProfile(profile *scw.Profile) (*scw.Profile, error) {
+	if profile.SecretKey == nil || !keyring.IsReference(*profile.SecretKey) {
+		return profile, nil
+	}
+
+	secretKey, err := keyring.Resolve(*profile.SecretKey)
+	if err != nil {
+		return nil, &platform.ClientError{
+			Err:     fmt.Errorf("cannot resolve secret key: %w", err),
+			Details: `The secret key is stored in the system keyring, it can be stored again using the command "scw init keyring=true".`,
+		}
+	}
+
+	resolved := *profile
+	resolved.SecretKey = &secretKey
+	return &resolved, nil
+}
+
 func errIsConfigFileNotFound(err error) bool {
 	var target *scw.ConfigFileNotFoundError
 	return errors.As(err, &target)
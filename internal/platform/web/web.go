@@ -1,6 +1,7 @@
 package web
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -14,7 +15,7 @@ type Platform struct {
 	APIUrl                string
 }
 
-func (p *Platform) CreateClient(client *http.Client, _ string, _ string) (*scw.Client, error) {
+func (p *Platform) CreateClient(_ context.Context, client *http.Client, _ string, _ string, _ func(string) string) (*scw.Client, error) {
 	opts := []scw.ClientOption{
 		scw.WithDefaultRegion(scw.RegionFrPar),
 		scw.WithDefaultZone(scw.ZoneFrPar1),
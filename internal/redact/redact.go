@@ -0,0 +1,124 @@
+// Package redact masks secrets in the debug and verbose output of the CLI.
+// A masked secret keeps its last 4 characters so users can still tell which key is used.
+package redact
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"gopkg.in/yaml.v3"
+)
+
+const visibleChars = 4
+
+var (
+	secretsMu sync.RWMutex
+	secrets   = map[string]bool{}
+
+	// secretHeaders are the HTTP headers whose value is a secret.
+	secretHeaders = map[string]bool{
+		"Authorization": true,
+		"X-Auth-Token":  true,
+	}
+
+	// secretJSONField matches the JSON fields holding a secret, e.g. the secret key of a new API key.
+	secretJSONField = regexp.MustCompile(`"(secret_key|token|password)"(\s*:\s*)"([^"]*)"`)
+
+	// secretArgName matches the names of the command arguments whose value is a secret, e.g. secret-key or the express secret-key of init.
+	secretArgName = regexp.MustCompile(`secret|token|password|passphrase|express`)
+)
+
+// Secret masks all but the last 4 characters of a secret.
+// Dashes are kept so a masked UUID still looks like a UUID.
+func Secret(secret string) string {
+	if len(secret) <= visibleChars {
+		return strings.Repeat("x", len(secret))
+	}
+	masked := []rune(secret[:len(secret)-visibleChars])
+	for i, r := range masked {
+		if r != '-' {
+			masked[i] = 'x'
+		}
+	}
+	return string(masked) + secret[len(secret)-visibleChars:]
+}
+
+// Register adds a secret that String masks wherever it appears.
+func Register(secret string) {
+	if secret == "" {
+		return
+	}
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+	secrets[secret] = true
+}
+
+// String masks the registered secrets and the secret JSON fields found in s.
+func String(s string) string {
+	secretsMu.RLock()
+	for secret := range secrets {
+		s = strings.ReplaceAll(s, secret, Secret(secret))
+	}
+	secretsMu.RUnlock()
+
+	return secretJSONField.ReplaceAllStringFunc(s, func(field string) string {
+		match := secretJSONField.FindStringSubmatch(field)
+		return `"` + match[1] + `"` + match[2] + `"` + Secret(match[3]) + `"`
+	})
+}
+
+// Arg masks the value of a name=value command argument when its name refers to a secret,
+// and the registered secrets found anywhere else in the argument.
+func Arg(arg string) string {
+	name, value, isNamed := strings.Cut(arg, "=")
+	if isNamed && secretArgName.MatchString(name) {
+		return name + "=" + Secret(value)
+	}
+	return String(arg)
+}
+
+// IsSecretHeader reports whether the value of the given HTTP header is a secret.
+func IsSecretHeader(key string) bool {
+	return secretHeaders[http.CanonicalHeaderKey(key)]
+}
+
+// Header returns the value of an HTTP header, masked when the header holds a secret.
+func Header(key string, value string) string {
+	if IsSecretHeader(key) {
+		return Secret(value)
+	}
+	return value
+}
+
+// Config returns the YAML of a config with the secret key of every profile masked.
+func Config(config *scw.Config) string {
+	// The config is copied through YAML so the caller's config is left untouched
+	configRaw, _ := yaml.Marshal(config)
+	redacted := &scw.Config{}
+	_ = yaml.Unmarshal(configRaw, redacted)
+
+	redactProfile(&redacted.Profile)
+	for _, profile := range redacted.Profiles {
+		redactProfile(profile)
+	}
+
+	configRaw, _ = yaml.Marshal(redacted)
+	return string(configRaw)
+}
+
+// Profile returns the YAML of a profile with its secret key masked.
+func Profile(profile *scw.Profile) string {
+	redacted := *profile
+	redactProfile(&redacted)
+	profileRaw, _ := yaml.Marshal(redacted)
+	return string(profileRaw)
+}
+
+func redactProfile(profile *scw.Profile) {
+	if profile != nil && profile.SecretKey != nil {
+		profile.SecretKey = scw.StringPtr(Secret(*profile.SecretKey))
+	}
+}
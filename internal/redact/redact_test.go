@@ -0,0 +1,58 @@
+package redact_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-cli/v2/internal/redact"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecret(t *testing.T) {
+	assert.Equal(t, "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx1234", redact.Secret("11111111-1111-1111-1111-111111111234"))
+	assert.Equal(t, "xxxx", redact.Secret("abcd"))
+	assert.Equal(t, "", redact.Secret(""))
+}
+
+func TestString(t *testing.T) {
+	redact.Register("22222222-2222-2222-2222-222222225678")
+
+	assert.Equal(t,
+		"token is xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx5678",
+		redact.String("token is 22222222-2222-2222-2222-222222225678"),
+	)
+	assert.Equal(t,
+		`{"id":"1","secret_key": "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx9999"}`,
+		redact.String(`{"id":"1","secret_key": "33333333-3333-3333-3333-333333339999"}`),
+	)
+}
+
+func TestArg(t *testing.T) {
+	assert.Equal(t, "secret-key=xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx1234", redact.Arg("secret-key=11111111-1111-1111-1111-111111111234"))
+	assert.Equal(t, "users.0.password=xxxxxxxx4321", redact.Arg("users.0.password=abcdefgh4321"))
+	assert.Equal(t, "name=my-server", redact.Arg("name=my-server"))
+	assert.Equal(t, "instance", redact.Arg("instance"))
+}
+
+func TestHeader(t *testing.T) {
+	assert.Equal(t, "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx1234", redact.Header("x-auth-token", "11111111-1111-1111-1111-111111111234"))
+	assert.Equal(t, "application/json", redact.Header("Content-Type", "application/json"))
+}
+
+func TestConfig(t *testing.T) {
+	config := &scw.Config{
+		Profile: scw.Profile{
+			SecretKey: scw.StringPtr("11111111-1111-1111-1111-111111111234"),
+		},
+		Profiles: map[string]*scw.Profile{
+			"prod": {SecretKey: scw.StringPtr("22222222-2222-2222-2222-222222225678")},
+		},
+	}
+
+	redacted := redact.Config(config)
+	assert.Contains(t, redacted, "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx1234")
+	assert.Contains(t, redacted, "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx5678")
+	assert.NotContains(t, redacted, "11111111")
+	// The given config is left untouched
+	assert.Equal(t, "11111111-1111-1111-1111-111111111234", *config.SecretKey)
+}
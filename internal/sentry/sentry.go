@@ -18,19 +18,31 @@ An error occurred, we are sorry, please consider opening a ticket on github usin
 Give us as many details as possible so we can reproduce the error and fix it.
 ---------------------------------------------------------------------------------------`
 
+// reportsEnabled is false when the user disabled crash reports in the CLI config
+var reportsEnabled = true
+
+// SetReportsEnabled sets whether RecoverPanicAndSendReport sends a report, the error is logged either way.
+func SetReportsEnabled(enabled bool) {
+	reportsEnabled = enabled
+}
+
 // RecoverPanicAndSendReport is to be called after recover.
 // It expects tags returned by core.BuildInfo and the recovered error
 func RecoverPanicAndSendReport(tags map[string]string, version string, e interface{}) {
-	sentryHub, err := sentryHub(tags, version)
-	if err != nil {
-		logger.Debugf("cannot get sentry hub: %s", err)
+	var hub *sentry.Hub
+	if reportsEnabled {
+		var err error
+		hub, err = sentryHub(tags, version)
+		if err != nil {
+			logger.Debugf("cannot get sentry hub: %s", err)
+		}
 	}
 
 	err, isError := e.(error)
 	if isError {
-		logAndSentry(sentryHub, err)
+		logAndSentry(hub, err)
 	} else {
-		logAndSentry(sentryHub, fmt.Errorf("unknown error: %v", e))
+		logAndSentry(hub, fmt.Errorf("unknown error: %v", e))
 	}
 }
 
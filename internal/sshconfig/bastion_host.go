@@ -23,7 +23,7 @@ func (b BastionHost) Config() string {
   User %s
 `,
 			host.name(),
-			host.user())
+			host.user()) + host.identityFileConfig()
 	}
 
 	return bastionConfig
@@ -6,6 +6,8 @@ type SimpleHost struct {
 	Name    string
 	Address string
 	User    string
+	// IdentityFile is the private key used to connect to the host, ssh default keys are used when empty
+	IdentityFile string
 }
 
 func (h SimpleHost) Config() string {
@@ -15,7 +17,14 @@ func (h SimpleHost) Config() string {
 `,
 		h.name(),
 		h.address(),
-		h.user())
+		h.user()) + h.identityFileConfig()
+}
+
+func (h SimpleHost) identityFileConfig() string {
+	if h.IdentityFile == "" {
+		return ""
+	}
+	return fmt.Sprintf("  IdentityFile %s\n", h.IdentityFile)
 }
 
 func (h SimpleHost) name() string {
@@ -22,6 +22,9 @@ var (
 	sshConfigFolderMode = os.FileMode(0700)
 
 	ErrFileNotFound = errors.New("file not found")
+
+	// generatedHeader warns that the generated file is overwritten, hosts should be added to the default config instead
+	generatedHeader = "# Generated by scw instance ssh install-config, manual changes are overwritten on the next run\n\n"
 )
 
 type Host interface {
@@ -30,6 +33,7 @@ type Host interface {
 
 func Generate(hosts []Host) ([]byte, error) {
 	configBuffer := bytes.NewBuffer(nil)
+	configBuffer.WriteString(generatedHeader)
 
 	for _, host := range hosts {
 		configBuffer.WriteString(host.Config())
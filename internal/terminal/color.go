@@ -0,0 +1,8 @@
+package terminal
+
+// Color modes accepted by SetColorMode
+const (
+	ColorAuto   = "auto"
+	ColorAlways = "always"
+	ColorNever  = "never"
+)
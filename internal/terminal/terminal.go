@@ -3,6 +3,7 @@
 package terminal
 
 import (
+	"fmt"
 	"os"
 
 	"golang.org/x/term"
@@ -10,6 +11,25 @@ import (
 	"github.com/fatih/color"
 )
 
+// defaultNoColor is the color decision of fatih/color, based on the NO_COLOR env variable and whether stdout is a tty
+var defaultNoColor = color.NoColor
+
+// SetColorMode decides whether output is colored.
+// In auto mode colors are used only when stdout is a tty and the NO_COLOR env variable is not set.
+func SetColorMode(mode string) error {
+	switch mode {
+	case ColorAuto:
+		color.NoColor = defaultNoColor
+	case ColorAlways:
+		color.NoColor = false
+	case ColorNever:
+		color.NoColor = true
+	default:
+		return fmt.Errorf("invalid color mode %s, valid modes are: %s, %s, %s", mode, ColorAuto, ColorAlways, ColorNever)
+	}
+	return nil
+}
+
 func Style(msg string, styles ...color.Attribute) string {
 	return color.New(styles...).Sprint(msg)
 }
@@ -32,5 +52,5 @@ func GetHeight() int {
 
 // IsTerm returns if stdout is considered a tty
 func IsTerm() bool {
-	return !color.NoColor
+	return term.IsTerminal(int(os.Stdout.Fd()))
 }
@@ -3,6 +3,8 @@
 package terminal
 
 import (
+	"fmt"
+
 	"github.com/fatih/color"
 )
 
@@ -11,6 +13,21 @@ var (
 	Height int = 0
 )
 
+// SetColorMode decides whether output is colored.
+// In auto mode the color setting of the wasm output config is kept.
+func SetColorMode(mode string) error {
+	switch mode {
+	case ColorAuto:
+	case ColorAlways:
+		color.NoColor = false
+	case ColorNever:
+		color.NoColor = true
+	default:
+		return fmt.Errorf("invalid color mode %s, valid modes are: %s, %s, %s", mode, ColorAuto, ColorAlways, ColorNever)
+	}
+	return nil
+}
+
 func Style(msg string, styles ...color.Attribute) string {
 	return color.New(styles...).Sprint(msg)
 }